@@ -0,0 +1,318 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// BanChatMemberParams are the parameters of the banChatMember method.
+type BanChatMemberParams struct {
+	// Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+	// Optional. Date when the user will be unbanned. If the user is banned for more than 366 days or less than 30 seconds from the current time they are considered to be banned forever. Applied for supergroups and channels only.
+	UntilDate time.Time `json:"-"`
+	// Optional. Pass True to delete all messages from the chat for the user that is being removed. If False, the user will be able to see messages in the group that were sent before the user was removed. Always True for supergroups and channels.
+	RevokeMessages *bool `json:"revoke_messages,omitempty"`
+}
+
+// MarshalJSON encodes the params, with UntilDate as Unix time.
+func (p *BanChatMemberParams) MarshalJSON() ([]byte, error) {
+	type params BanChatMemberParams
+	return json.Marshal(&struct {
+		*params
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{
+		params:    (*params)(p),
+		UntilDate: unixTime(p.UntilDate),
+	})
+}
+
+// RestrictChatMemberParams are the parameters of the restrictChatMember
+// method.
+type RestrictChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+	// New user permissions
+	Permissions *ChatPermissions `json:"permissions"`
+	// Optional. Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios, can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UseIndependentChatPermissions *bool `json:"use_independent_chat_permissions,omitempty"`
+	// Optional. Date when restrictions will be lifted for the user. If the user is restricted for more than 366 days or less than 30 seconds from the current time, they are considered to be restricted forever.
+	UntilDate time.Time `json:"-"`
+}
+
+// MarshalJSON encodes the params, with UntilDate as Unix time.
+func (p *RestrictChatMemberParams) MarshalJSON() ([]byte, error) {
+	type params RestrictChatMemberParams
+	return json.Marshal(&struct {
+		*params
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{
+		params:    (*params)(p),
+		UntilDate: unixTime(p.UntilDate),
+	})
+}
+
+// PromoteChatMemberParams are the parameters of the promoteChatMember
+// method. Leave all the rights unset to demote a user.
+type PromoteChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+	// Optional. Pass True if the administrator's presence in the chat is hidden
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
+	// Optional. Pass True if the administrator can access the chat event log, get boost list, see hidden supergroup and channel members, report spam messages and ignore slow mode. Implied by any other administrator privilege.
+	CanManageChat *bool `json:"can_manage_chat,omitempty"`
+	// Optional. Pass True if the administrator can delete messages of other users
+	CanDeleteMessages *bool `json:"can_delete_messages,omitempty"`
+	// Optional. Pass True if the administrator can manage video chats
+	CanManageVideoChats *bool `json:"can_manage_video_chats,omitempty"`
+	// Optional. Pass True if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanRestrictMembers *bool `json:"can_restrict_members,omitempty"`
+	// Optional. Pass True if the administrator can add new administrators with a subset of their own privileges or demote administrators that they have promoted, directly or indirectly
+	CanPromoteMembers *bool `json:"can_promote_members,omitempty"`
+	// Optional. Pass True if the administrator can change chat title, photo and other settings
+	CanChangeInfo *bool `json:"can_change_info,omitempty"`
+	// Optional. Pass True if the administrator can invite new users to the chat
+	CanInviteUsers *bool `json:"can_invite_users,omitempty"`
+	// Optional. Pass True if the administrator can post messages in the channel; channels only
+	CanPostMessages *bool `json:"can_post_messages,omitempty"`
+	// Optional. Pass True if the administrator can edit messages of other users and can pin messages; channels only
+	CanEditMessages *bool `json:"can_edit_messages,omitempty"`
+	// Optional. Pass True if the administrator can pin messages; supergroups only
+	CanPinMessages *bool `json:"can_pin_messages,omitempty"`
+	// Optional. Pass True if the user is allowed to create, rename, close, and reopen forum topics; supergroups only
+	CanManageTopics *bool `json:"can_manage_topics,omitempty"`
+}
+
+// unixTime returns t as Unix time, or 0 if t is the zero time.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// Until returns the date when the restrictions of the member will be lifted,
+// or the zero time if they are permanent.
+func (m *ChatMemberRestricted) Until() time.Time {
+	return fromUnixTime(m.UntilDate)
+}
+
+// Until returns the date when the member will be unbanned, or the zero time
+// if the ban is permanent.
+func (m *ChatMemberBanned) Until() time.Time {
+	return fromUnixTime(m.UntilDate)
+}
+
+// fromUnixTime returns the time for the Unix time t, or the zero time if t
+// is 0.
+func fromUnixTime(t int64) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(t, 0)
+}
+
+// BanChatMember bans a user from a group, supergroup or channel. The bot
+// must be an administrator with the appropriate rights.
+func (t *ApiClient) BanChatMember(p *BanChatMemberParams) error {
+	return t.BanChatMemberContext(context.Background(), p)
+}
+
+// BanChatMemberContext is like BanChatMember, but the request is bound to
+// ctx.
+func (t *ApiClient) BanChatMemberContext(ctx context.Context, p *BanChatMemberParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "banChatMember", p, &ok)
+}
+
+// UnbanChatMember unbans a previously banned user. If onlyIfBanned is false
+// and the user is a member of the chat, they will be removed from it.
+func (t *ApiClient) UnbanChatMember(chatId string, userId int64, onlyIfBanned bool) error {
+	return t.UnbanChatMemberContext(context.Background(), chatId, userId, onlyIfBanned)
+}
+
+// UnbanChatMemberContext is like UnbanChatMember, but the request is bound to
+// ctx.
+func (t *ApiClient) UnbanChatMemberContext(ctx context.Context, chatId string, userId int64, onlyIfBanned bool) error {
+	params := map[string]interface{}{
+		"chat_id":        chatId,
+		"user_id":        userId,
+		"only_if_banned": onlyIfBanned,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "unbanChatMember", params, &ok)
+}
+
+// RestrictChatMember restricts a user in a supergroup. Pass all permissions
+// set to lift the restrictions.
+func (t *ApiClient) RestrictChatMember(p *RestrictChatMemberParams) error {
+	return t.RestrictChatMemberContext(context.Background(), p)
+}
+
+// RestrictChatMemberContext is like RestrictChatMember, but the request is
+// bound to ctx.
+func (t *ApiClient) RestrictChatMemberContext(ctx context.Context, p *RestrictChatMemberParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "restrictChatMember", p, &ok)
+}
+
+// PromoteChatMember promotes or demotes a user in a supergroup or channel.
+func (t *ApiClient) PromoteChatMember(p *PromoteChatMemberParams) error {
+	return t.PromoteChatMemberContext(context.Background(), p)
+}
+
+// PromoteChatMemberContext is like PromoteChatMember, but the request is
+// bound to ctx.
+func (t *ApiClient) PromoteChatMemberContext(ctx context.Context, p *PromoteChatMemberParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "promoteChatMember", p, &ok)
+}
+
+// SetChatAdministratorCustomTitle sets a custom title, of up to 16
+// characters, for an administrator promoted by the bot in a supergroup.
+func (t *ApiClient) SetChatAdministratorCustomTitle(chatId string, userId int64, title string) error {
+	return t.SetChatAdministratorCustomTitleContext(context.Background(), chatId, userId, title)
+}
+
+// SetChatAdministratorCustomTitleContext is like
+// SetChatAdministratorCustomTitle, but the request is bound to ctx.
+func (t *ApiClient) SetChatAdministratorCustomTitleContext(ctx context.Context, chatId string, userId int64, title string) error {
+	params := map[string]interface{}{
+		"chat_id":      chatId,
+		"user_id":      userId,
+		"custom_title": title,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "setChatAdministratorCustomTitle", params, &ok)
+}
+
+// GetChatAdministrators returns the administrators of a chat that are not
+// bots, either a *ChatMemberOwner or a *ChatMemberAdministrator.
+func (t *ApiClient) GetChatAdministrators(chatId string) ([]ChatMember, error) {
+	return t.GetChatAdministratorsContext(context.Background(), chatId)
+}
+
+// GetChatAdministratorsContext is like GetChatAdministrators, but the request
+// is bound to ctx.
+func (t *ApiClient) GetChatAdministratorsContext(ctx context.Context, chatId string) ([]ChatMember, error) {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	var members []json.RawMessage
+	if err := t.CallContext(ctx, "POST", "getChatAdministrators", params, &members); err != nil {
+		return nil, err
+	}
+	return unmarshalChatMemberList(members)
+}
+
+// GetChatMember returns information about a member of a chat. Use a type
+// switch on the result to check the status of the member; statuses added by
+// newer API versions are returned as *UnknownChatMember.
+func (t *ApiClient) GetChatMember(chatId string, userId int64) (ChatMember, error) {
+	return t.GetChatMemberContext(context.Background(), chatId, userId)
+}
+
+// GetChatMemberContext is like GetChatMember, but the request is bound to
+// ctx.
+func (t *ApiClient) GetChatMemberContext(ctx context.Context, chatId string, userId int64) (ChatMember, error) {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+		"user_id": userId,
+	}
+	var member json.RawMessage
+	if err := t.CallContext(ctx, "POST", "getChatMember", params, &member); err != nil {
+		return nil, err
+	}
+	return unmarshalChatMember(member)
+}
+
+// GetChatMemberCount returns the number of members in a chat.
+func (t *ApiClient) GetChatMemberCount(chatId string) (int, error) {
+	return t.GetChatMemberCountContext(context.Background(), chatId)
+}
+
+// GetChatMemberCountContext is like GetChatMemberCount, but the request is
+// bound to ctx.
+func (t *ApiClient) GetChatMemberCountContext(ctx context.Context, chatId string) (int, error) {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	var count int
+	if err := t.CallContext(ctx, "POST", "getChatMemberCount", params, &count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// LeaveChat makes the bot leave a group, supergroup or channel.
+func (t *ApiClient) LeaveChat(chatId string) error {
+	return t.LeaveChatContext(context.Background(), chatId)
+}
+
+// LeaveChatContext is like LeaveChat, but the request is bound to ctx.
+func (t *ApiClient) LeaveChatContext(ctx context.Context, chatId string) error {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "leaveChat", params, &ok)
+}
+
+// PinChatMessage adds a message to the list of pinned messages in a chat.
+// If silent is true, chat members are not notified.
+func (t *ApiClient) PinChatMessage(chatId string, messageId int64, silent bool) error {
+	return t.PinChatMessageContext(context.Background(), chatId, messageId, silent)
+}
+
+// PinChatMessageContext is like PinChatMessage, but the request is bound to
+// ctx.
+func (t *ApiClient) PinChatMessageContext(ctx context.Context, chatId string, messageId int64, silent bool) error {
+	params := map[string]interface{}{
+		"chat_id":              chatId,
+		"message_id":           messageId,
+		"disable_notification": silent,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "pinChatMessage", params, &ok)
+}
+
+// UnpinChatMessage removes a message from the list of pinned messages in a
+// chat. If messageId is 0, the most recent pinned message is unpinned.
+func (t *ApiClient) UnpinChatMessage(chatId string, messageId int64) error {
+	return t.UnpinChatMessageContext(context.Background(), chatId, messageId)
+}
+
+// UnpinChatMessageContext is like UnpinChatMessage, but the request is bound
+// to ctx.
+func (t *ApiClient) UnpinChatMessageContext(ctx context.Context, chatId string, messageId int64) error {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	if messageId != 0 {
+		params["message_id"] = messageId
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "unpinChatMessage", params, &ok)
+}
+
+// UnpinAllChatMessages clears the list of pinned messages in a chat.
+func (t *ApiClient) UnpinAllChatMessages(chatId string) error {
+	return t.UnpinAllChatMessagesContext(context.Background(), chatId)
+}
+
+// UnpinAllChatMessagesContext is like UnpinAllChatMessages, but the request
+// is bound to ctx.
+func (t *ApiClient) UnpinAllChatMessagesContext(ctx context.Context, chatId string) error {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "unpinAllChatMessages", params, &ok)
+}
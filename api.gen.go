@@ -1,21 +1,36 @@
 package telegram
 
+import (
+	"context"
+	"encoding/json"
+)
+
 type User struct {
 	// Unique identifier for this user or bot
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id"`
 	// User‘s or bot’s first name
-	FirstName string `json:"first_name,omitempty"`
+	FirstName string `json:"first_name"`
 	// Optional. User‘s or bot’s last name
 	LastName string `json:"last_name,omitempty"`
 	// Optional. User‘s or bot’s username
 	Username string `json:"username,omitempty"`
 }
 
+// ChatType is a value of the type field of Chat.
+type ChatType string
+
+const (
+	ChatTypePrivate    ChatType = "private"
+	ChatTypeGroup      ChatType = "group"
+	ChatTypeSupergroup ChatType = "supergroup"
+	ChatTypeChannel    ChatType = "channel"
+)
+
 type Chat struct {
 	// Unique identifier for this chat. This number may be greater than 32 bits and some programming languages may have difficulty/silent defects in interpreting it. But it smaller than 52 bits, so a signed 64 bit integer or double-precision float type are safe for storing this identifier.
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id"`
 	// Type of chat, can be either “private”, “group”, “supergroup” or “channel”
-	Type string `json:"type,omitempty"`
+	Type ChatType `json:"type"`
 	// Optional. Title, for supergroups, channels and group chats
 	Title string `json:"title,omitempty"`
 	// Optional. Username, for private chats, supergroups and channels if available
@@ -24,27 +39,31 @@ type Chat struct {
 	FirstName string `json:"first_name,omitempty"`
 	// Optional. Last name of the other party in a private chat
 	LastName string `json:"last_name,omitempty"`
+	// Optional. True, if the supergroup chat is a forum (has topics enabled)
+	IsForum bool `json:"is_forum,omitempty"`
 }
 
 type Message struct {
 	// Unique message identifier
-	MessageId int64 `json:"message_id,omitempty"`
+	MessageId int64 `json:"message_id"`
 	// Optional. Sender, can be empty for messages sent to channels
 	From *User `json:"from,omitempty"`
 	// Date the message was sent in Unix time
-	Date int64 `json:"date,omitempty"`
+	Date int64 `json:"date"`
 	// Conversation the message belongs to
-	Chat *Chat `json:"chat,omitempty"`
+	Chat *Chat `json:"chat"`
+	// Optional. Information about the original message for forwarded messages
+	ForwardOrigin MessageOrigin `json:"forward_origin,omitempty"`
 	// Optional. For forwarded messages, sender of the original message
 	ForwardFrom *User `json:"forward_from,omitempty"`
 	// Optional. For messages forwarded from a channel, information about the original channel
 	ForwardFromChat *Chat `json:"forward_from_chat,omitempty"`
 	// Optional. For forwarded messages, date the original message was sent in Unix time
-	ForwardDate int64 `json:"forward_date,omitempty"`
+	ForwardDate *int64 `json:"forward_date,omitempty"`
 	// Optional. For replies, the original message. Note that the Message object in this field will not contain further reply_to_message fields even if it itself is a reply.
 	ReplyToMessage *Message `json:"reply_to_message,omitempty"`
 	// Optional. Date the message was last edited in Unix time
-	EditDate int64 `json:"edit_date,omitempty"`
+	EditDate *int64 `json:"edit_date,omitempty"`
 	// Optional. For text messages, the actual UTF-8 text of the message, 0-4096 characters.
 	Text string `json:"text,omitempty"`
 	// Optional. For text messages, special entities like usernames, URLs, bot commands, etc. that appear in the text
@@ -69,6 +88,8 @@ type Message struct {
 	Contact *Contact `json:"contact,omitempty"`
 	// Optional. Message is a shared location, information about the location
 	Location *Location `json:"location,omitempty"`
+	// Optional. Message is a dice with random value
+	Dice *Dice `json:"dice,omitempty"`
 	// Optional. Message is a venue, information about the venue
 	Venue *Venue `json:"venue,omitempty"`
 	// Optional. A new member was added to the group, information about them (this member may be the bot itself)
@@ -88,20 +109,54 @@ type Message struct {
 	// Optional. Service message: the channel has been created. This field can‘t be received in a message coming through updates, because bot can’t be a member of a channel when it is created. It can only be found in reply_to_message if someone replies to a very first message in a channel.
 	ChannelChatCreated bool `json:"channel_chat_created,omitempty"`
 	// Optional. The group has been migrated to a supergroup with the specified identifier. This number may be greater than 32 bits and some programming languages may have difficulty/silent defects in interpreting it. But it smaller than 52 bits, so a signed 64 bit integer or double-precision float type are safe for storing this identifier.
-	MigrateToChatId int64 `json:"migrate_to_chat_id,omitempty"`
+	MigrateToChatId *int64 `json:"migrate_to_chat_id,omitempty"`
 	// Optional. The supergroup has been migrated from a group with the specified identifier. This number may be greater than 32 bits and some programming languages may have difficulty/silent defects in interpreting it. But it smaller than 52 bits, so a signed 64 bit integer or double-precision float type are safe for storing this identifier.
-	MigrateFromChatId int64 `json:"migrate_from_chat_id,omitempty"`
+	MigrateFromChatId *int64 `json:"migrate_from_chat_id,omitempty"`
 	// Optional. Specified message was pinned. Note that the Message object in this field will not contain further reply_to_message fields even if it is itself a reply.
 	PinnedMessage *Message `json:"pinned_message,omitempty"`
 }
 
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *Message) UnmarshalJSON(b []byte) error {
+	type alias Message
+	aux := struct {
+		*alias
+		ForwardOrigin json.RawMessage `json:"forward_origin"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.ForwardOrigin, err = unmarshalMessageOrigin(aux.ForwardOrigin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MessageEntityType is a value of the type field of MessageEntity.
+type MessageEntityType string
+
+const (
+	MessageEntityTypeMention     MessageEntityType = "mention"
+	MessageEntityTypeHashtag     MessageEntityType = "hashtag"
+	MessageEntityTypeBotCommand  MessageEntityType = "bot_command"
+	MessageEntityTypeUrl         MessageEntityType = "url"
+	MessageEntityTypeEmail       MessageEntityType = "email"
+	MessageEntityTypeBold        MessageEntityType = "bold"
+	MessageEntityTypeItalic      MessageEntityType = "italic"
+	MessageEntityTypeCode        MessageEntityType = "code"
+	MessageEntityTypePre         MessageEntityType = "pre"
+	MessageEntityTypeTextLink    MessageEntityType = "text_link"
+	MessageEntityTypeTextMention MessageEntityType = "text_mention"
+)
+
 type MessageEntity struct {
 	// Type of the entity. Can be mention (@username), hashtag, bot_command, url, email, bold (bold text), italic (italic text), code (monowidth string), pre (monowidth block), text_link (for clickable text URLs), text_mention (for users without usernames)
-	Type string `json:"type,omitempty"`
+	Type MessageEntityType `json:"type"`
 	// Offset in UTF-16 code units to the start of the entity
-	Offset int64 `json:"offset,omitempty"`
+	Offset int64 `json:"offset"`
 	// Length of the entity in UTF-16 code units
-	Length int64 `json:"length,omitempty"`
+	Length int64 `json:"length"`
 	// Optional. For “text_link” only, url that will be opened after user taps on the text
 	Url string `json:"url,omitempty"`
 	// Optional. For “text_mention” only, the mentioned user
@@ -110,20 +165,20 @@ type MessageEntity struct {
 
 type PhotoSize struct {
 	// Unique identifier for this file
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Photo width
-	Width int64 `json:"width,omitempty"`
+	Width int64 `json:"width"`
 	// Photo height
-	Height int64 `json:"height,omitempty"`
+	Height int64 `json:"height"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type Audio struct {
 	// Unique identifier for this file
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Duration of the audio in seconds as defined by sender
-	Duration int64 `json:"duration,omitempty"`
+	Duration int64 `json:"duration"`
 	// Optional. Performer of the audio as defined by sender or by audio tags
 	Performer string `json:"performer,omitempty"`
 	// Optional. Title of the audio as defined by sender or by audio tags
@@ -131,12 +186,12 @@ type Audio struct {
 	// Optional. MIME type of the file as defined by sender
 	MimeType string `json:"mime_type,omitempty"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type Document struct {
 	// Unique file identifier
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Optional. Document thumbnail as defined by sender
 	Thumb *PhotoSize `json:"thumb,omitempty"`
 	// Optional. Original filename as defined by sender
@@ -144,132 +199,139 @@ type Document struct {
 	// Optional. MIME type of the file as defined by sender
 	MimeType string `json:"mime_type,omitempty"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type Sticker struct {
 	// Unique identifier for this file
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Sticker width
-	Width int64 `json:"width,omitempty"`
+	Width int64 `json:"width"`
 	// Sticker height
-	Height int64 `json:"height,omitempty"`
+	Height int64 `json:"height"`
 	// Optional. Sticker thumbnail in .webp or .jpg format
 	Thumb *PhotoSize `json:"thumb,omitempty"`
 	// Optional. Emoji associated with the sticker
 	Emoji string `json:"emoji,omitempty"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type Video struct {
 	// Unique identifier for this file
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Video width as defined by sender
-	Width int64 `json:"width,omitempty"`
+	Width int64 `json:"width"`
 	// Video height as defined by sender
-	Height int64 `json:"height,omitempty"`
+	Height int64 `json:"height"`
 	// Duration of the video in seconds as defined by sender
-	Duration int64 `json:"duration,omitempty"`
+	Duration int64 `json:"duration"`
 	// Optional. Video thumbnail
 	Thumb *PhotoSize `json:"thumb,omitempty"`
 	// Optional. Mime type of a file as defined by sender
 	MimeType string `json:"mime_type,omitempty"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type Voice struct {
 	// Unique identifier for this file
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Duration of the audio in seconds as defined by sender
-	Duration int64 `json:"duration,omitempty"`
+	Duration int64 `json:"duration"`
 	// Optional. MIME type of the file as defined by sender
 	MimeType string `json:"mime_type,omitempty"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type Contact struct {
 	// Contact's phone number
-	PhoneNumber string `json:"phone_number,omitempty"`
+	PhoneNumber string `json:"phone_number"`
 	// Contact's first name
-	FirstName string `json:"first_name,omitempty"`
+	FirstName string `json:"first_name"`
 	// Optional. Contact's last name
 	LastName string `json:"last_name,omitempty"`
 	// Optional. Contact's user identifier in Telegram
-	UserId int64 `json:"user_id,omitempty"`
+	UserId *int64 `json:"user_id,omitempty"`
 }
 
 type Location struct {
 	// Longitude as defined by sender
-	Longitude float64 `json:"longitude,omitempty"`
+	Longitude float64 `json:"longitude"`
 	// Latitude as defined by sender
-	Latitude float64 `json:"latitude,omitempty"`
+	Latitude float64 `json:"latitude"`
 }
 
 type Venue struct {
 	// Venue location
-	Location *Location `json:"location,omitempty"`
+	Location *Location `json:"location"`
 	// Name of the venue
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// Address of the venue
-	Address string `json:"address,omitempty"`
+	Address string `json:"address"`
 	// Optional. Foursquare identifier of the venue
 	FoursquareId string `json:"foursquare_id,omitempty"`
 }
 
 type UserProfilePhotos struct {
 	// Total number of profile pictures the target user has
-	TotalCount int64 `json:"total_count,omitempty"`
+	TotalCount int64 `json:"total_count"`
 	// Requested profile pictures (in up to 4 sizes each)
-	Photos [][]*PhotoSize `json:"photos,omitempty"`
+	Photos [][]*PhotoSize `json:"photos"`
 }
 
 type File struct {
 	// Unique identifier for this file
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Optional. File size, if known
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 	// Optional. File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file.
 	FilePath string `json:"file_path,omitempty"`
 }
 
 type ReplyKeyboardMarkup struct {
 	// Array of button rows, each represented by an Array of KeyboardButton objects
-	Keyboard [][]*KeyboardButton `json:"keyboard,omitempty"`
+	Keyboard [][]*KeyboardButton `json:"keyboard"`
 	// Optional. Requests clients to resize the keyboard vertically for optimal fit (e.g., make the keyboard smaller if there are just two rows of buttons). Defaults to false, in which case the custom keyboard is always of the same height as the app's standard keyboard.
-	ResizeKeyboard bool `json:"resize_keyboard,omitempty"`
+	ResizeKeyboard *bool `json:"resize_keyboard,omitempty"`
 	// Optional. Requests clients to hide the keyboard as soon as it's been used. The keyboard will still be available, but clients will automatically display the usual letter-keyboard in the chat – the user can press a special button in the input field to see the custom keyboard again. Defaults to false.
-	OneTimeKeyboard bool `json:"one_time_keyboard,omitempty"`
+	OneTimeKeyboard *bool `json:"one_time_keyboard,omitempty"`
 	// Optional. Use this parameter if you want to show the keyboard to specific users only. Targets: 1) users that are @mentioned in the text of the Message object; 2) if the bot's message is a reply (has reply_to_message_id), sender of the original message.
-	Selective bool `json:"selective,omitempty"`
+	Selective *bool `json:"selective,omitempty"`
 }
 
 type KeyboardButton struct {
 	// Text of the button. If none of the optional fields are used, it will be sent to the bot as a message when the button is pressed
-	Text string `json:"text,omitempty"`
+	Text string `json:"text"`
 	// Optional. If True, the user's phone number will be sent as a contact when the button is pressed. Available in private chats only
-	RequestContact bool `json:"request_contact,omitempty"`
+	RequestContact *bool `json:"request_contact,omitempty"`
 	// Optional. If True, the user's current location will be sent when the button is pressed. Available in private chats only
-	RequestLocation bool `json:"request_location,omitempty"`
+	RequestLocation *bool `json:"request_location,omitempty"`
 }
 
 type ReplyKeyboardHide struct {
 	// Requests clients to hide the custom keyboard
-	HideKeyboard bool `json:"hide_keyboard,omitempty"`
+	HideKeyboard bool `json:"hide_keyboard"`
 	// Optional. Use this parameter if you want to hide keyboard for specific users only. Targets: 1) users that are @mentioned in the text of the Message object; 2) if the bot's message is a reply (has reply_to_message_id), sender of the original message.
-	Selective bool `json:"selective,omitempty"`
+	Selective *bool `json:"selective,omitempty"`
+}
+
+type ReplyKeyboardRemove struct {
+	// Requests clients to remove the custom keyboard (user will not be able to summon this keyboard; if you want to hide the keyboard from sight but keep it accessible, use one_time_keyboard in ReplyKeyboardMarkup)
+	RemoveKeyboard bool `json:"remove_keyboard"`
+	// Optional. Use this parameter if you want to remove the keyboard for specific users only. Targets: 1) users that are @mentioned in the text of the Message object; 2) if the bot's message is a reply to a message in the same chat and forum topic, sender of the original message.
+	Selective *bool `json:"selective,omitempty"`
 }
 
 type InlineKeyboardMarkup struct {
 	// Array of button rows, each represented by an Array of InlineKeyboardButton objects
-	InlineKeyboard [][]*InlineKeyboardButton `json:"inline_keyboard,omitempty"`
+	InlineKeyboard [][]*InlineKeyboardButton `json:"inline_keyboard"`
 }
 
 type InlineKeyboardButton struct {
 	// Label text on the button
-	Text string `json:"text,omitempty"`
+	Text string `json:"text"`
 	// Optional. HTTP url to be opened when button is pressed
 	Url string `json:"url,omitempty"`
 	// Optional. Data to be sent in a callback query to the bot when button is pressed, 1-64 bytes
@@ -280,34 +342,264 @@ type InlineKeyboardButton struct {
 
 type CallbackQuery struct {
 	// Unique identifier for this query
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Sender
-	From *User `json:"from,omitempty"`
+	From *User `json:"from"`
 	// Optional. Message with the callback button that originated the query. Note that message content and message date will not be available if the message is too old
 	Message *Message `json:"message,omitempty"`
 	// Optional. Identifier of the message sent via the bot in inline mode, that originated the query
 	InlineMessageId string `json:"inline_message_id,omitempty"`
 	// Data associated with the callback button. Be aware that a bad client can send arbitrary data in this field
-	Data string `json:"data,omitempty"`
+	Data string `json:"data"`
 }
 
 type ForceReply struct {
 	// Shows reply interface to the user, as if they manually selected the bot‘s message and tapped ’Reply'
-	ForceReply bool `json:"force_reply,omitempty"`
+	ForceReply bool `json:"force_reply"`
 	// Optional. Use this parameter if you want to force reply from specific users only. Targets: 1) users that are @mentioned in the text of the Message object; 2) if the bot's message is a reply (has reply_to_message_id), sender of the original message.
-	Selective bool `json:"selective,omitempty"`
+	Selective *bool `json:"selective,omitempty"`
+}
+
+// ChatMember is one of its variant types, such as ChatMemberOwner.
+type ChatMember interface {
+	chatMember()
+}
+
+func (*ChatMemberOwner) chatMember()         {}
+func (*ChatMemberAdministrator) chatMember() {}
+func (*ChatMemberMember) chatMember()        {}
+func (*ChatMemberRestricted) chatMember()    {}
+func (*ChatMemberLeft) chatMember()          {}
+func (*ChatMemberBanned) chatMember()        {}
+
+// UnknownChatMember is a ChatMember variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownChatMember struct {
+	// Status is the status of the value.
+	Status string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownChatMember) chatMember() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownChatMember) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalChatMember decodes b into the ChatMember variant named by its status field.
+// Unknown variants, from newer API versions, are decoded as *UnknownChatMember.
+func unmarshalChatMember(b json.RawMessage) (ChatMember, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"status"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v ChatMember
+	switch d.Value {
+	case "creator":
+		v = new(ChatMemberOwner)
+	case "administrator":
+		v = new(ChatMemberAdministrator)
+	case "member":
+		v = new(ChatMemberMember)
+	case "restricted":
+		v = new(ChatMemberRestricted)
+	case "left":
+		v = new(ChatMemberLeft)
+	case "kicked":
+		v = new(ChatMemberBanned)
+	default:
+		return &UnknownChatMember{Status: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
 }
 
-type ChatMember struct {
+// unmarshalChatMemberList decodes each item of bs with unmarshalChatMember.
+func unmarshalChatMemberList(bs []json.RawMessage) ([]ChatMember, error) {
+	var list []ChatMember
+	for _, b := range bs {
+		v, err := unmarshalChatMember(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type ChatMemberOwner struct {
+	// The member's status in the chat, always “creator”
+	Status string `json:"status"`
 	// Information about the user
-	User *User `json:"user,omitempty"`
-	// The member's status in the chat. Can be “creator”, “administrator”, “member”, “left” or “kicked”
-	Status string `json:"status,omitempty"`
+	User *User `json:"user"`
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+	// Optional. Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// MarshalJSON encodes v with status set to "creator".
+func (v *ChatMemberOwner) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberOwner
+	a := alias(*v)
+	a.Status = "creator"
+	return json.Marshal(&a)
+}
+
+type ChatMemberAdministrator struct {
+	// The member's status in the chat, always “administrator”
+	Status string `json:"status"`
+	// Information about the user
+	User *User `json:"user"`
+	// True, if the bot is allowed to edit administrator privileges of that user
+	CanBeEdited bool `json:"can_be_edited"`
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+	// True, if the administrator can access the chat event log, get boost list, see hidden supergroup and channel members, report spam messages and ignore slow mode
+	CanManageChat bool `json:"can_manage_chat"`
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	// True, if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members"`
+	// True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that they have promoted
+	CanPromoteMembers bool `json:"can_promote_members"`
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// Optional. True, if the administrator can post messages in the channel; channels only
+	CanPostMessages *bool `json:"can_post_messages,omitempty"`
+	// Optional. True, if the administrator can edit messages of other users and can pin messages; channels only
+	CanEditMessages *bool `json:"can_edit_messages,omitempty"`
+	// Optional. True, if the user is allowed to pin messages; groups and supergroups only
+	CanPinMessages *bool `json:"can_pin_messages,omitempty"`
+	// Optional. True, if the user is allowed to create, rename, close, and reopen forum topics; supergroups only
+	CanManageTopics *bool `json:"can_manage_topics,omitempty"`
+	// Optional. Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+}
+
+// MarshalJSON encodes v with status set to "administrator".
+func (v *ChatMemberAdministrator) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberAdministrator
+	a := alias(*v)
+	a.Status = "administrator"
+	return json.Marshal(&a)
+}
+
+type ChatMemberMember struct {
+	// The member's status in the chat, always “member”
+	Status string `json:"status"`
+	// Information about the user
+	User *User `json:"user"`
+	// Optional. Date when the user's subscription will expire; Unix time
+	UntilDate *int64 `json:"until_date,omitempty"`
+}
+
+// MarshalJSON encodes v with status set to "member".
+func (v *ChatMemberMember) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberMember
+	a := alias(*v)
+	a.Status = "member"
+	return json.Marshal(&a)
+}
+
+type ChatMemberRestricted struct {
+	// The member's status in the chat, always “restricted”
+	Status string `json:"status"`
+	// Information about the user
+	User *User `json:"user"`
+	// True, if the user is a member of the chat at the moment of the request
+	IsMember bool `json:"is_member"`
+	// True, if the user is allowed to send text messages, contacts, giveaways, giveaway winners, invoices, locations and venues
+	CanSendMessages bool `json:"can_send_messages"`
+	// True, if the user is allowed to send audios
+	CanSendAudios bool `json:"can_send_audios"`
+	// True, if the user is allowed to send documents
+	CanSendDocuments bool `json:"can_send_documents"`
+	// True, if the user is allowed to send photos
+	CanSendPhotos bool `json:"can_send_photos"`
+	// True, if the user is allowed to send videos
+	CanSendVideos bool `json:"can_send_videos"`
+	// True, if the user is allowed to send video notes
+	CanSendVideoNotes bool `json:"can_send_video_notes"`
+	// True, if the user is allowed to send voice notes
+	CanSendVoiceNotes bool `json:"can_send_voice_notes"`
+	// True, if the user is allowed to send polls
+	CanSendPolls bool `json:"can_send_polls"`
+	// True, if the user is allowed to send animations, games, stickers and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages"`
+	// True, if the user is allowed to add web page previews to their messages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// True, if the user is allowed to pin messages
+	CanPinMessages bool `json:"can_pin_messages"`
+	// True, if the user is allowed to create forum topics
+	CanManageTopics bool `json:"can_manage_topics"`
+	// Date when restrictions will be lifted for this user; Unix time. If 0, then the user is restricted forever
+	UntilDate int64 `json:"until_date"`
+}
+
+// MarshalJSON encodes v with status set to "restricted".
+func (v *ChatMemberRestricted) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberRestricted
+	a := alias(*v)
+	a.Status = "restricted"
+	return json.Marshal(&a)
+}
+
+type ChatMemberLeft struct {
+	// The member's status in the chat, always “left”
+	Status string `json:"status"`
+	// Information about the user
+	User *User `json:"user"`
+}
+
+// MarshalJSON encodes v with status set to "left".
+func (v *ChatMemberLeft) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberLeft
+	a := alias(*v)
+	a.Status = "left"
+	return json.Marshal(&a)
+}
+
+type ChatMemberBanned struct {
+	// The member's status in the chat, always “kicked”
+	Status string `json:"status"`
+	// Information about the user
+	User *User `json:"user"`
+	// Date when restrictions will be lifted for this user; Unix time. If 0, then the user is banned forever
+	UntilDate int64 `json:"until_date"`
+}
+
+// MarshalJSON encodes v with status set to "kicked".
+func (v *ChatMemberBanned) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberBanned
+	a := alias(*v)
+	a.Status = "kicked"
+	return json.Marshal(&a)
 }
 
 type Update struct {
 	// The update‘s unique identifier. Update identifiers start from a certain positive number and increase sequentially. This ID becomes especially handy if you’re using Webhooks, since it allows you to ignore repeated updates or to restore the correct update sequence, should they get out of order.
-	UpdateId int64 `json:"update_id,omitempty"`
+	UpdateId int64 `json:"update_id"`
 	// Optional. New incoming message of any kind — text, photo, sticker, etc.
 	Message *Message `json:"message,omitempty"`
 	// Optional. New version of a message that is known to the bot and was edited
@@ -318,41 +610,45 @@ type Update struct {
 	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result,omitempty"`
 	// Optional. New incoming callback query
 	CallbackQuery *CallbackQuery `json:"callback_query,omitempty"`
+	// Optional. The bot's chat member status was updated in a chat. For private chats, this update is received only when the bot is blocked or unblocked by the user.
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member,omitempty"`
+	// Optional. A chat member's status was updated in a chat. The bot must be an administrator in the chat and must explicitly specify “chat_member” in the list of allowed_updates to receive these updates.
+	ChatMember *ChatMemberUpdated `json:"chat_member,omitempty"`
 }
 
 type InlineQuery struct {
 	// Unique identifier for this query
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Sender
-	From *User `json:"from,omitempty"`
+	From *User `json:"from"`
 	// Optional. Sender location, only for bots that request user location
 	Location *Location `json:"location,omitempty"`
 	// Text of the query (up to 512 characters)
-	Query string `json:"query,omitempty"`
+	Query string `json:"query"`
 	// Offset of the results to be returned, can be controlled by the bot
-	Offset string `json:"offset,omitempty"`
+	Offset string `json:"offset"`
 }
 
 type ChosenInlineResult struct {
 	// The unique identifier for the result that was chosen
-	ResultId string `json:"result_id,omitempty"`
+	ResultId string `json:"result_id"`
 	// The user that chose the result
-	From *User `json:"from,omitempty"`
+	From *User `json:"from"`
 	// Optional. Sender location, only for bots that require user location
 	Location *Location `json:"location,omitempty"`
 	// Optional. Identifier of the sent inline message. Available only if there is an inline keyboard attached to the message. Will be also received in callback queries and can be used to edit the message.
 	InlineMessageId string `json:"inline_message_id,omitempty"`
 	// The query that was used to obtain the result
-	Query string `json:"query,omitempty"`
+	Query string `json:"query"`
 }
 
 type Game struct {
 	// Title of the game
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// Description of the game
-	Description string `json:"description,omitempty"`
+	Description string `json:"description"`
 	// Photo that will be displayed in the game message in chats.
-	Photo []*PhotoSize `json:"photo,omitempty"`
+	Photo []*PhotoSize `json:"photo"`
 	// Optional. Brief description of the game or high scores included in the game message. Can be automatically edited to include current high scores for the game when the bot calls setGameScore, or manually edited using editMessageText. 0-4096 characters.
 	Text string `json:"text,omitempty"`
 	// Optional. Special entities that appear in text, such as usernames, URLs, bot commands, etc.
@@ -363,7 +659,7 @@ type Game struct {
 
 type Animation struct {
 	// Unique file identifier
-	FileId string `json:"file_id,omitempty"`
+	FileId string `json:"file_id"`
 	// Optional. Animation thumbnail as defined by sender
 	Thumb *PhotoSize `json:"thumb,omitempty"`
 	// Optional. Original animation filename as defined by sender
@@ -371,7 +667,7 @@ type Animation struct {
 	// Optional. MIME type of the file as defined by sender
 	MimeType string `json:"mime_type,omitempty"`
 	// Optional. File size
-	FileSize int64 `json:"file_size,omitempty"`
+	FileSize *int64 `json:"file_size,omitempty"`
 }
 
 type CallbackGame struct {
@@ -379,9 +675,4880 @@ type CallbackGame struct {
 
 type GameHighScore struct {
 	// Position in high score table for the game
-	Position int64 `json:"position,omitempty"`
+	Position int64 `json:"position"`
 	// User
-	User *User `json:"user,omitempty"`
+	User *User `json:"user"`
 	// Score
-	Score int64 `json:"score,omitempty"`
+	Score int64 `json:"score"`
+}
+
+type WebhookInfo struct {
+	// Webhook URL, may be empty if webhook is not set up
+	Url string `json:"url"`
+	// True, if a custom certificate was provided for webhook certificate checks
+	HasCustomCertificate bool `json:"has_custom_certificate"`
+	// Number of updates awaiting delivery
+	PendingUpdateCount int64 `json:"pending_update_count"`
+	// Optional. Currently used webhook IP address
+	IpAddress string `json:"ip_address,omitempty"`
+	// Optional. Unix time for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorDate *int64 `json:"last_error_date,omitempty"`
+	// Optional. Error message in human-readable format for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorMessage string `json:"last_error_message,omitempty"`
+	// Optional. Unix time of the most recent error that happened when trying to synchronize available updates with Telegram datacenters
+	LastSynchronizationErrorDate *int64 `json:"last_synchronization_error_date,omitempty"`
+	// Optional. The maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery
+	MaxConnections *int64 `json:"max_connections,omitempty"`
+	// Optional. A list of update types the bot is subscribed to. Defaults to all update types except chat_member
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+type ResponseParameters struct {
+	// Optional. The group has been migrated to a supergroup with the specified identifier. This number may be greater than 32 bits and some programming languages may have difficulty/silent defects in interpreting it. But it smaller than 52 bits, so a signed 64 bit integer or double-precision float type are safe for storing this identifier.
+	MigrateToChatId *int64 `json:"migrate_to_chat_id,omitempty"`
+	// Optional. In case of exceeding flood control, the number of seconds left to wait before the request can be repeated
+	RetryAfter *int64 `json:"retry_after,omitempty"`
+}
+
+// InlineQueryResult is one of its variant types, such as InlineQueryResultCachedAudio.
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
+
+func (*InlineQueryResultCachedAudio) inlineQueryResult()    {}
+func (*InlineQueryResultCachedDocument) inlineQueryResult() {}
+func (*InlineQueryResultCachedGif) inlineQueryResult()      {}
+func (*InlineQueryResultCachedMpeg4Gif) inlineQueryResult() {}
+func (*InlineQueryResultCachedPhoto) inlineQueryResult()    {}
+func (*InlineQueryResultCachedSticker) inlineQueryResult()  {}
+func (*InlineQueryResultCachedVideo) inlineQueryResult()    {}
+func (*InlineQueryResultCachedVoice) inlineQueryResult()    {}
+func (*InlineQueryResultArticle) inlineQueryResult()        {}
+func (*InlineQueryResultAudio) inlineQueryResult()          {}
+func (*InlineQueryResultContact) inlineQueryResult()        {}
+func (*InlineQueryResultGame) inlineQueryResult()           {}
+func (*InlineQueryResultDocument) inlineQueryResult()       {}
+func (*InlineQueryResultGif) inlineQueryResult()            {}
+func (*InlineQueryResultLocation) inlineQueryResult()       {}
+func (*InlineQueryResultMpeg4Gif) inlineQueryResult()       {}
+func (*InlineQueryResultPhoto) inlineQueryResult()          {}
+func (*InlineQueryResultVenue) inlineQueryResult()          {}
+func (*InlineQueryResultVideo) inlineQueryResult()          {}
+func (*InlineQueryResultVoice) inlineQueryResult()          {}
+
+// UnknownInlineQueryResult is a InlineQueryResult variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownInlineQueryResult struct {
+	// Type is the type of the value.
+	Type string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownInlineQueryResult) inlineQueryResult() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownInlineQueryResult) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalInlineQueryResult decodes b into the InlineQueryResult variant named by its type field.
+// Unknown variants, from newer API versions, are decoded as *UnknownInlineQueryResult.
+func unmarshalInlineQueryResult(b json.RawMessage) (InlineQueryResult, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value          string          `json:"type"`
+		AudioFileId    json.RawMessage `json:"audio_file_id"`
+		DocumentFileId json.RawMessage `json:"document_file_id"`
+		GifFileId      json.RawMessage `json:"gif_file_id"`
+		Mpeg4FileId    json.RawMessage `json:"mpeg4_file_id"`
+		PhotoFileId    json.RawMessage `json:"photo_file_id"`
+		VideoFileId    json.RawMessage `json:"video_file_id"`
+		VoiceFileId    json.RawMessage `json:"voice_file_id"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v InlineQueryResult
+	switch d.Value {
+	case "audio":
+		switch {
+		case d.AudioFileId != nil:
+			v = new(InlineQueryResultCachedAudio)
+		default:
+			v = new(InlineQueryResultAudio)
+		}
+	case "document":
+		switch {
+		case d.DocumentFileId != nil:
+			v = new(InlineQueryResultCachedDocument)
+		default:
+			v = new(InlineQueryResultDocument)
+		}
+	case "gif":
+		switch {
+		case d.GifFileId != nil:
+			v = new(InlineQueryResultCachedGif)
+		default:
+			v = new(InlineQueryResultGif)
+		}
+	case "mpeg4_gif":
+		switch {
+		case d.Mpeg4FileId != nil:
+			v = new(InlineQueryResultCachedMpeg4Gif)
+		default:
+			v = new(InlineQueryResultMpeg4Gif)
+		}
+	case "photo":
+		switch {
+		case d.PhotoFileId != nil:
+			v = new(InlineQueryResultCachedPhoto)
+		default:
+			v = new(InlineQueryResultPhoto)
+		}
+	case "sticker":
+		v = new(InlineQueryResultCachedSticker)
+	case "video":
+		switch {
+		case d.VideoFileId != nil:
+			v = new(InlineQueryResultCachedVideo)
+		default:
+			v = new(InlineQueryResultVideo)
+		}
+	case "voice":
+		switch {
+		case d.VoiceFileId != nil:
+			v = new(InlineQueryResultCachedVoice)
+		default:
+			v = new(InlineQueryResultVoice)
+		}
+	case "article":
+		v = new(InlineQueryResultArticle)
+	case "contact":
+		v = new(InlineQueryResultContact)
+	case "game":
+		v = new(InlineQueryResultGame)
+	case "location":
+		v = new(InlineQueryResultLocation)
+	case "venue":
+		v = new(InlineQueryResultVenue)
+	default:
+		return &UnknownInlineQueryResult{Type: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalInlineQueryResultList decodes each item of bs with unmarshalInlineQueryResult.
+func unmarshalInlineQueryResultList(bs []json.RawMessage) ([]InlineQueryResult, error) {
+	var list []InlineQueryResult
+	for _, b := range bs {
+		v, err := unmarshalInlineQueryResult(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type InlineQueryResultArticle struct {
+	// Type of the result, must be article
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 Bytes
+	Id string `json:"id"`
+	// Title of the result
+	Title string `json:"title"`
+	// Content of the message to be sent
+	InputMessageContent InputMessageContent `json:"input_message_content"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. URL of the result
+	Url string `json:"url,omitempty"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Url of the thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
+	// Optional. Thumbnail width
+	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
+	// Optional. Thumbnail height
+	ThumbnailHeight *int64 `json:"thumbnail_height,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "article".
+func (v *InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultArticle
+	a := alias(*v)
+	a.Type = "article"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultArticle) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultArticle
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultPhoto struct {
+	// Type of the result, must be photo
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid URL of the photo. Photo must be in JPEG format. Photo size must not exceed 5MB
+	PhotoUrl string `json:"photo_url"`
+	// URL of the thumbnail for the photo
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Optional. Width of the photo
+	PhotoWidth *int64 `json:"photo_width,omitempty"`
+	// Optional. Height of the photo
+	PhotoHeight *int64 `json:"photo_height,omitempty"`
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the photo
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "photo".
+func (v *InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultPhoto
+	a := alias(*v)
+	a.Type = "photo"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultPhoto) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultPhoto
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultGif struct {
+	// Type of the result, must be gif
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid URL for the GIF file. File size must not exceed 1MB
+	GifUrl string `json:"gif_url"`
+	// Optional. Width of the GIF
+	GifWidth *int64 `json:"gif_width,omitempty"`
+	// Optional. Height of the GIF
+	GifHeight *int64 `json:"gif_height,omitempty"`
+	// Optional. Duration of the GIF in seconds
+	GifDuration *int64 `json:"gif_duration,omitempty"`
+	// URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Optional. MIME type of the thumbnail, must be one of “image/jpeg”, “image/gif”, or “video/mp4”. Defaults to “image/jpeg”
+	ThumbnailMimeType string `json:"thumbnail_mime_type,omitempty"`
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+	// Optional. Caption of the GIF file to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the GIF animation
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "gif".
+func (v *InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGif
+	a := alias(*v)
+	a.Type = "gif"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultGif) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultGif
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultMpeg4Gif struct {
+	// Type of the result, must be mpeg4_gif
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid URL for the MPEG4 file. File size must not exceed 1MB
+	Mpeg4Url string `json:"mpeg4_url"`
+	// Optional. Video width
+	Mpeg4Width *int64 `json:"mpeg4_width,omitempty"`
+	// Optional. Video height
+	Mpeg4Height *int64 `json:"mpeg4_height,omitempty"`
+	// Optional. Video duration in seconds
+	Mpeg4Duration *int64 `json:"mpeg4_duration,omitempty"`
+	// URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Optional. MIME type of the thumbnail, must be one of “image/jpeg”, “image/gif”, or “video/mp4”. Defaults to “image/jpeg”
+	ThumbnailMimeType string `json:"thumbnail_mime_type,omitempty"`
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+	// Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the video animation
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "mpeg4_gif".
+func (v *InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultMpeg4Gif
+	a := alias(*v)
+	a.Type = "mpeg4_gif"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultMpeg4Gif) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultMpeg4Gif
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultVideo struct {
+	// Type of the result, must be video
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid URL for the embedded video player or video file
+	VideoUrl string `json:"video_url"`
+	// MIME type of the content of the video URL, “text/html” or “video/mp4”
+	MimeType string `json:"mime_type"`
+	// URL of the thumbnail (JPEG only) for the video
+	ThumbnailUrl string `json:"thumbnail_url"`
+	// Title for the result
+	Title string `json:"title"`
+	// Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Video width
+	VideoWidth *int64 `json:"video_width,omitempty"`
+	// Optional. Video height
+	VideoHeight *int64 `json:"video_height,omitempty"`
+	// Optional. Video duration in seconds
+	VideoDuration *int64 `json:"video_duration,omitempty"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the video
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "video".
+func (v *InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVideo
+	a := alias(*v)
+	a.Type = "video"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultVideo) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultVideo
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultAudio struct {
+	// Type of the result, must be audio
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid URL for the audio file
+	AudioUrl string `json:"audio_url"`
+	// Title
+	Title string `json:"title"`
+	// Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Performer
+	Performer string `json:"performer,omitempty"`
+	// Optional. Audio duration in seconds
+	AudioDuration *int64 `json:"audio_duration,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the audio
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "audio".
+func (v *InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultAudio
+	a := alias(*v)
+	a.Type = "audio"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultAudio) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultAudio
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultVoice struct {
+	// Type of the result, must be voice
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid URL for the voice recording
+	VoiceUrl string `json:"voice_url"`
+	// Recording title
+	Title string `json:"title"`
+	// Optional. Caption of the voice message to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Recording duration in seconds
+	VoiceDuration *int64 `json:"voice_duration,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the voice recording
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "voice".
+func (v *InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVoice
+	a := alias(*v)
+	a.Type = "voice"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultVoice) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultVoice
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultDocument struct {
+	// Type of the result, must be document
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// Title for the result
+	Title string `json:"title"`
+	// Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// A valid URL for the file
+	DocumentUrl string `json:"document_url"`
+	// MIME type of the content of the file, either “application/pdf” or “application/zip”
+	MimeType string `json:"mime_type"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the file
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+	// Optional. Url of the thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
+	// Optional. Thumbnail width
+	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
+	// Optional. Thumbnail height
+	ThumbnailHeight *int64 `json:"thumbnail_height,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "document".
+func (v *InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultDocument
+	a := alias(*v)
+	a.Type = "document"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultDocument) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultDocument
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultLocation struct {
+	// Type of the result, must be location
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// Location latitude in degrees
+	Latitude float64 `json:"latitude"`
+	// Location longitude in degrees
+	Longitude float64 `json:"longitude"`
+	// Location title
+	Title string `json:"title"`
+	// Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy *float64 `json:"horizontal_accuracy,omitempty"`
+	// Optional. Period in seconds during which the location can be updated
+	LivePeriod *int64 `json:"live_period,omitempty"`
+	// Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading *int64 `json:"heading,omitempty"`
+	// Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius *int64 `json:"proximity_alert_radius,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the location
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+	// Optional. Url of the thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
+	// Optional. Thumbnail width
+	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
+	// Optional. Thumbnail height
+	ThumbnailHeight *int64 `json:"thumbnail_height,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "location".
+func (v *InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultLocation
+	a := alias(*v)
+	a.Type = "location"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultLocation) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultLocation
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultVenue struct {
+	// Type of the result, must be venue
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// Latitude of the venue location in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude of the venue location in degrees
+	Longitude float64 `json:"longitude"`
+	// Title of the venue
+	Title string `json:"title"`
+	// Address of the venue
+	Address string `json:"address"`
+	// Optional. Foursquare identifier of the venue if known
+	FoursquareId string `json:"foursquare_id,omitempty"`
+	// Optional. Foursquare type of the venue, if known
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Optional. Google Places identifier of the venue
+	GooglePlaceId string `json:"google_place_id,omitempty"`
+	// Optional. Google Places type of the venue
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the venue
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+	// Optional. Url of the thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
+	// Optional. Thumbnail width
+	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
+	// Optional. Thumbnail height
+	ThumbnailHeight *int64 `json:"thumbnail_height,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "venue".
+func (v *InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVenue
+	a := alias(*v)
+	a.Type = "venue"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultVenue) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultVenue
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultContact struct {
+	// Type of the result, must be contact
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+	// Contact's first name
+	FirstName string `json:"first_name"`
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the contact
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+	// Optional. Url of the thumbnail for the result
+	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
+	// Optional. Thumbnail width
+	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
+	// Optional. Thumbnail height
+	ThumbnailHeight *int64 `json:"thumbnail_height,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "contact".
+func (v *InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultContact
+	a := alias(*v)
+	a.Type = "contact"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultContact) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultContact
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultGame struct {
+	// Type of the result, must be game
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// Short name of the game
+	GameShortName string `json:"game_short_name"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "game".
+func (v *InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGame
+	a := alias(*v)
+	a.Type = "game"
+	return json.Marshal(&a)
+}
+
+type InlineQueryResultCachedPhoto struct {
+	// Type of the result, must be photo
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier of the photo
+	PhotoFileId string `json:"photo_file_id"`
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the photo
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "photo".
+func (v *InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedPhoto
+	a := alias(*v)
+	a.Type = "photo"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedPhoto) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedPhoto
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedGif struct {
+	// Type of the result, must be gif
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier for the GIF file
+	GifFileId string `json:"gif_file_id"`
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+	// Optional. Caption of the GIF file to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the GIF animation
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "gif".
+func (v *InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedGif
+	a := alias(*v)
+	a.Type = "gif"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedGif) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedGif
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedMpeg4Gif struct {
+	// Type of the result, must be mpeg4_gif
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier for the MPEG4 file
+	Mpeg4FileId string `json:"mpeg4_file_id"`
+	// Optional. Title for the result
+	Title string `json:"title,omitempty"`
+	// Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the video animation
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "mpeg4_gif".
+func (v *InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedMpeg4Gif
+	a := alias(*v)
+	a.Type = "mpeg4_gif"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedMpeg4Gif) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedMpeg4Gif
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedSticker struct {
+	// Type of the result, must be sticker
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier of the sticker
+	StickerFileId string `json:"sticker_file_id"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the sticker
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "sticker".
+func (v *InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedSticker
+	a := alias(*v)
+	a.Type = "sticker"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedSticker) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedSticker
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedDocument struct {
+	// Type of the result, must be document
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// Title for the result
+	Title string `json:"title"`
+	// A valid file identifier for the file
+	DocumentFileId string `json:"document_file_id"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the file
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "document".
+func (v *InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedDocument
+	a := alias(*v)
+	a.Type = "document"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedDocument) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedDocument
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedVideo struct {
+	// Type of the result, must be video
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier for the video file
+	VideoFileId string `json:"video_file_id"`
+	// Title for the result
+	Title string `json:"title"`
+	// Optional. Short description of the result
+	Description string `json:"description,omitempty"`
+	// Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the video
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "video".
+func (v *InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedVideo
+	a := alias(*v)
+	a.Type = "video"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedVideo) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedVideo
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedVoice struct {
+	// Type of the result, must be voice
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier for the voice message
+	VoiceFileId string `json:"voice_file_id"`
+	// Voice message title
+	Title string `json:"title"`
+	// Optional. Caption of the voice message to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the voice message
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "voice".
+func (v *InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedVoice
+	a := alias(*v)
+	a.Type = "voice"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedVoice) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedVoice
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultCachedAudio struct {
+	// Type of the result, must be audio
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	Id string `json:"id"`
+	// A valid file identifier for the audio file
+	AudioFileId string `json:"audio_file_id"`
+	// Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Optional. Content of the message to be sent instead of the audio
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "audio".
+func (v *InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedAudio
+	a := alias(*v)
+	a.Type = "audio"
+	return json.Marshal(&a)
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *InlineQueryResultCachedAudio) UnmarshalJSON(b []byte) error {
+	type alias InlineQueryResultCachedAudio
+	aux := struct {
+		*alias
+		InputMessageContent json.RawMessage `json:"input_message_content"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.InputMessageContent, err = unmarshalInputMessageContent(aux.InputMessageContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InlineQueryResultsButton struct {
+	// Label text on the button
+	Text string `json:"text"`
+	// Optional. Deep-linking parameter for the /start message sent to the bot when a user presses the button. 1-64 characters, only A-Z, a-z, 0-9, _ and - are allowed.
+	StartParameter string `json:"start_parameter,omitempty"`
+}
+
+// InputMessageContent is one of its variant types, such as InputTextMessageContent.
+type InputMessageContent interface {
+	inputMessageContent()
+}
+
+func (*InputTextMessageContent) inputMessageContent()     {}
+func (*InputLocationMessageContent) inputMessageContent() {}
+func (*InputVenueMessageContent) inputMessageContent()    {}
+func (*InputContactMessageContent) inputMessageContent()  {}
+
+// unmarshalInputMessageContent decodes b into the InputMessageContent variant with its fields.
+func unmarshalInputMessageContent(b json.RawMessage) (InputMessageContent, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		MessageText json.RawMessage `json:"message_text"`
+		Title       json.RawMessage `json:"title"`
+		PhoneNumber json.RawMessage `json:"phone_number"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v InputMessageContent
+	switch {
+	case d.MessageText != nil:
+		v = new(InputTextMessageContent)
+	case d.Title != nil:
+		v = new(InputVenueMessageContent)
+	case d.PhoneNumber != nil:
+		v = new(InputContactMessageContent)
+	default:
+		v = new(InputLocationMessageContent)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalInputMessageContentList decodes each item of bs with unmarshalInputMessageContent.
+func unmarshalInputMessageContentList(bs []json.RawMessage) ([]InputMessageContent, error) {
+	var list []InputMessageContent
+	for _, b := range bs {
+		v, err := unmarshalInputMessageContent(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type InputTextMessageContent struct {
+	// Text of the message to be sent, 1-4096 characters
+	MessageText string `json:"message_text"`
+	// Optional. Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []*MessageEntity `json:"entities,omitempty"`
+	// Optional. Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+type InputLocationMessageContent struct {
+	// Latitude of the location in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude of the location in degrees
+	Longitude float64 `json:"longitude"`
+	// Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy *float64 `json:"horizontal_accuracy,omitempty"`
+	// Optional. Period in seconds during which the location can be updated
+	LivePeriod *int64 `json:"live_period,omitempty"`
+	// Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading *int64 `json:"heading,omitempty"`
+	// Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius *int64 `json:"proximity_alert_radius,omitempty"`
+}
+
+type InputVenueMessageContent struct {
+	// Latitude of the venue in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude of the venue in degrees
+	Longitude float64 `json:"longitude"`
+	// Name of the venue
+	Title string `json:"title"`
+	// Address of the venue
+	Address string `json:"address"`
+	// Optional. Foursquare identifier of the venue, if known
+	FoursquareId string `json:"foursquare_id,omitempty"`
+	// Optional. Foursquare type of the venue, if known
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Optional. Google Places identifier of the venue
+	GooglePlaceId string `json:"google_place_id,omitempty"`
+	// Optional. Google Places type of the venue
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+}
+
+type InputContactMessageContent struct {
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+	// Contact's first name
+	FirstName string `json:"first_name"`
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+}
+
+type LinkPreviewOptions struct {
+	// Optional. True, if the link preview is disabled
+	IsDisabled *bool `json:"is_disabled,omitempty"`
+	// Optional. URL to use for the link preview. If empty, then the first URL found in the message text will be used
+	Url string `json:"url,omitempty"`
+	// Optional. True, if the media in the link preview is supposed to be shrunk; ignored if the URL isn't explicitly specified or media size change isn't supported for the preview
+	PreferSmallMedia *bool `json:"prefer_small_media,omitempty"`
+	// Optional. True, if the media in the link preview is supposed to be enlarged; ignored if the URL isn't explicitly specified or media size change isn't supported for the preview
+	PreferLargeMedia *bool `json:"prefer_large_media,omitempty"`
+	// Optional. True, if the link preview must be shown above the message text; otherwise, the link preview will be shown below the message text
+	ShowAboveText *bool `json:"show_above_text,omitempty"`
+}
+
+type ReplyParameters struct {
+	// Identifier of the message that will be replied to in the current chat, or in the chat chat_id if it is specified
+	MessageId int64 `json:"message_id"`
+	// Optional. If the message to be replied to is from a different chat, unique identifier for the chat or username of the channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Pass True if the message should be sent even if the specified message to be replied to is not found
+	AllowSendingWithoutReply *bool `json:"allow_sending_without_reply,omitempty"`
+	// Optional. Quoted part of the message to be replied to; 0-1024 characters after entities parsing
+	Quote string `json:"quote,omitempty"`
+	// Optional. Mode for parsing entities in the quote. See formatting options for more details.
+	QuoteParseMode ParseMode `json:"quote_parse_mode,omitempty"`
+	// Optional. A JSON-serialized list of special entities that appear in the quote. It can be specified instead of quote_parse_mode.
+	QuoteEntities []*MessageEntity `json:"quote_entities,omitempty"`
+	// Optional. Position of the quote in the original message in UTF-16 code units
+	QuotePosition *int64 `json:"quote_position,omitempty"`
+}
+
+// InputMedia is one of its variant types, such as InputMediaAnimation.
+type InputMedia interface {
+	inputMedia()
+}
+
+func (*InputMediaAnimation) inputMedia() {}
+func (*InputMediaDocument) inputMedia()  {}
+func (*InputMediaAudio) inputMedia()     {}
+func (*InputMediaPhoto) inputMedia()     {}
+func (*InputMediaVideo) inputMedia()     {}
+
+// UnknownInputMedia is a InputMedia variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownInputMedia struct {
+	// Type is the type of the value.
+	Type string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownInputMedia) inputMedia() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownInputMedia) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalInputMedia decodes b into the InputMedia variant named by its type field.
+// Unknown variants, from newer API versions, are decoded as *UnknownInputMedia.
+func unmarshalInputMedia(b json.RawMessage) (InputMedia, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v InputMedia
+	switch d.Value {
+	case "animation":
+		v = new(InputMediaAnimation)
+	case "document":
+		v = new(InputMediaDocument)
+	case "audio":
+		v = new(InputMediaAudio)
+	case "photo":
+		v = new(InputMediaPhoto)
+	case "video":
+		v = new(InputMediaVideo)
+	default:
+		return &UnknownInputMedia{Type: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalInputMediaList decodes each item of bs with unmarshalInputMedia.
+func unmarshalInputMediaList(bs []json.RawMessage) ([]InputMedia, error) {
+	var list []InputMedia
+	for _, b := range bs {
+		v, err := unmarshalInputMedia(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type InputMediaPhoto struct {
+	// Type of the result, must be photo
+	Type string `json:"type"`
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or upload a new one using multipart/form-data.
+	Media *InputFile `json:"media"`
+	// Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Pass True if the photo needs to be covered with a spoiler animation
+	HasSpoiler *bool `json:"has_spoiler,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "photo".
+func (v *InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputMediaPhoto
+	a := alias(*v)
+	a.Type = "photo"
+	return json.Marshal(&a)
+}
+
+type InputMediaVideo struct {
+	// Type of the result, must be video
+	Type string `json:"type"`
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or upload a new one using multipart/form-data.
+	Media *InputFile `json:"media"`
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Video width
+	Width *int64 `json:"width,omitempty"`
+	// Optional. Video height
+	Height *int64 `json:"height,omitempty"`
+	// Optional. Video duration in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming *bool `json:"supports_streaming,omitempty"`
+	// Optional. Pass True if the video needs to be covered with a spoiler animation
+	HasSpoiler *bool `json:"has_spoiler,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "video".
+func (v *InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias InputMediaVideo
+	a := alias(*v)
+	a.Type = "video"
+	return json.Marshal(&a)
+}
+
+type InputMediaAnimation struct {
+	// Type of the result, must be animation
+	Type string `json:"type"`
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or upload a new one using multipart/form-data.
+	Media *InputFile `json:"media"`
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Caption of the animation to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Animation width
+	Width *int64 `json:"width,omitempty"`
+	// Optional. Animation height
+	Height *int64 `json:"height,omitempty"`
+	// Optional. Animation duration in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler *bool `json:"has_spoiler,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "animation".
+func (v *InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAnimation
+	a := alias(*v)
+	a.Type = "animation"
+	return json.Marshal(&a)
+}
+
+type InputMediaAudio struct {
+	// Type of the result, must be audio
+	Type string `json:"type"`
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or upload a new one using multipart/form-data.
+	Media *InputFile `json:"media"`
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Duration of the audio in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Performer of the audio
+	Performer string `json:"performer,omitempty"`
+	// Optional. Title of the audio
+	Title string `json:"title,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "audio".
+func (v *InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAudio
+	a := alias(*v)
+	a.Type = "audio"
+	return json.Marshal(&a)
+}
+
+type InputMediaDocument struct {
+	// Type of the result, must be document
+	Type string `json:"type"`
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or upload a new one using multipart/form-data.
+	Media *InputFile `json:"media"`
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Disables automatic server-side content type detection for files uploaded using multipart/form-data. Always True, if the document is sent as part of an album.
+	DisableContentTypeDetection *bool `json:"disable_content_type_detection,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "document".
+func (v *InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type alias InputMediaDocument
+	a := alias(*v)
+	a.Type = "document"
+	return json.Marshal(&a)
+}
+
+type MessageId struct {
+	// Unique message identifier
+	MessageId int64 `json:"message_id"`
+}
+
+type ChatPermissions struct {
+	// Optional. True, if the user is allowed to send text messages, contacts, giveaways, giveaway winners, invoices, locations and venues
+	CanSendMessages *bool `json:"can_send_messages,omitempty"`
+	// Optional. True, if the user is allowed to send audios
+	CanSendAudios *bool `json:"can_send_audios,omitempty"`
+	// Optional. True, if the user is allowed to send documents
+	CanSendDocuments *bool `json:"can_send_documents,omitempty"`
+	// Optional. True, if the user is allowed to send photos
+	CanSendPhotos *bool `json:"can_send_photos,omitempty"`
+	// Optional. True, if the user is allowed to send videos
+	CanSendVideos *bool `json:"can_send_videos,omitempty"`
+	// Optional. True, if the user is allowed to send video notes
+	CanSendVideoNotes *bool `json:"can_send_video_notes,omitempty"`
+	// Optional. True, if the user is allowed to send voice notes
+	CanSendVoiceNotes *bool `json:"can_send_voice_notes,omitempty"`
+	// Optional. True, if the user is allowed to send polls
+	CanSendPolls *bool `json:"can_send_polls,omitempty"`
+	// Optional. True, if the user is allowed to send animations, games, stickers and use inline bots
+	CanSendOtherMessages *bool `json:"can_send_other_messages,omitempty"`
+	// Optional. True, if the user is allowed to add web page previews to their messages
+	CanAddWebPagePreviews *bool `json:"can_add_web_page_previews,omitempty"`
+	// Optional. True, if the user is allowed to change the chat title, photo and other settings. Ignored in public supergroups
+	CanChangeInfo *bool `json:"can_change_info,omitempty"`
+	// Optional. True, if the user is allowed to invite new users to the chat
+	CanInviteUsers *bool `json:"can_invite_users,omitempty"`
+	// Optional. True, if the user is allowed to pin messages. Ignored in public supergroups
+	CanPinMessages *bool `json:"can_pin_messages,omitempty"`
+	// Optional. True, if the user is allowed to create forum topics. If omitted defaults to the value of can_pin_messages
+	CanManageTopics *bool `json:"can_manage_topics,omitempty"`
+}
+
+type ChatPhoto struct {
+	// File identifier of small (160x160) chat photo. This file_id can be used only for photo download and only for as long as the photo is not changed.
+	SmallFileId string `json:"small_file_id"`
+	// Unique file identifier of small (160x160) chat photo, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	SmallFileUniqueId string `json:"small_file_unique_id"`
+	// File identifier of big (640x640) chat photo. This file_id can be used only for photo download and only for as long as the photo is not changed.
+	BigFileId string `json:"big_file_id"`
+	// Unique file identifier of big (640x640) chat photo, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	BigFileUniqueId string `json:"big_file_unique_id"`
+}
+
+type ChatFullInfo struct {
+	// Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
+	Id int64 `json:"id"`
+	// Type of the chat, can be either “private”, “group”, “supergroup” or “channel”
+	Type ChatType `json:"type"`
+	// Optional. Title, for supergroups, channels and group chats
+	Title string `json:"title,omitempty"`
+	// Optional. Username, for private chats, supergroups and channels if available
+	Username string `json:"username,omitempty"`
+	// Optional. First name of the other party in a private chat
+	FirstName string `json:"first_name,omitempty"`
+	// Optional. Last name of the other party in a private chat
+	LastName string `json:"last_name,omitempty"`
+	// Optional. True, if the supergroup chat is a forum (has topics enabled)
+	IsForum bool `json:"is_forum,omitempty"`
+	// Identifier of the accent color for the chat name and backgrounds of the chat photo, reply header, and link preview
+	AccentColorId int64 `json:"accent_color_id"`
+	// The maximum number of reactions that can be set on a message in the chat
+	MaxReactionCount int64 `json:"max_reaction_count"`
+	// Optional. Chat photo
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// Optional. If non-empty, the list of all active chat usernames; for private chats, supergroups and channels
+	ActiveUsernames []string `json:"active_usernames,omitempty"`
+	// Optional. Bio of the other party in a private chat
+	Bio string `json:"bio,omitempty"`
+	// Optional. True, if privacy settings of the other party in the private chat allows to use tg://user?id=<user_id> links only in chats with the user
+	HasPrivateForwards bool `json:"has_private_forwards,omitempty"`
+	// Optional. True, if users need to join the supergroup before they can send messages
+	JoinToSendMessages bool `json:"join_to_send_messages,omitempty"`
+	// Optional. True, if all users directly joining the supergroup without using an invite link need to be approved by supergroup administrators
+	JoinByRequest bool `json:"join_by_request,omitempty"`
+	// Optional. Description, for groups, supergroups and channel chats
+	Description string `json:"description,omitempty"`
+	// Optional. Primary invite link, for groups, supergroups and channel chats
+	InviteLink string `json:"invite_link,omitempty"`
+	// Optional. The most recent pinned message (by sending date)
+	PinnedMessage *Message `json:"pinned_message,omitempty"`
+	// Optional. Default chat member permissions, for groups and supergroups
+	Permissions *ChatPermissions `json:"permissions,omitempty"`
+	// Optional. For supergroups, the minimum allowed delay between consecutive messages sent by each unprivileged user; in seconds
+	SlowModeDelay *int64 `json:"slow_mode_delay,omitempty"`
+	// Optional. The time after which all messages sent to the chat will be automatically deleted; in seconds
+	MessageAutoDeleteTime *int64 `json:"message_auto_delete_time,omitempty"`
+	// Optional. True, if messages from the chat can't be forwarded to other chats
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+	// Optional. For supergroups, name of the group sticker set
+	StickerSetName string `json:"sticker_set_name,omitempty"`
+	// Optional. True, if the bot can change the group sticker set
+	CanSetStickerSet bool `json:"can_set_sticker_set,omitempty"`
+	// Optional. Unique identifier for the linked chat, i.e. the discussion group identifier for a channel and vice versa; for supergroups and channel chats.
+	LinkedChatId *int64 `json:"linked_chat_id,omitempty"`
+}
+
+type ChatInviteLink struct {
+	// The invite link. If the link was created by another chat administrator, then the second part of the link will be replaced with “…”.
+	InviteLink string `json:"invite_link"`
+	// Creator of the link
+	Creator *User `json:"creator"`
+	// True, if users joining the chat via the link need to be approved by chat administrators
+	CreatesJoinRequest bool `json:"creates_join_request"`
+	// True, if the link is primary
+	IsPrimary bool `json:"is_primary"`
+	// True, if the link is revoked
+	IsRevoked bool `json:"is_revoked"`
+	// Optional. Invite link name
+	Name string `json:"name,omitempty"`
+	// Optional. Point in time (Unix timestamp) when the link will expire or has been expired
+	ExpireDate *int64 `json:"expire_date,omitempty"`
+	// Optional. The maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit *int64 `json:"member_limit,omitempty"`
+	// Optional. Number of pending join requests created using this link
+	PendingJoinRequestCount *int64 `json:"pending_join_request_count,omitempty"`
+}
+
+type Dice struct {
+	// Emoji on which the dice throw animation is based
+	Emoji string `json:"emoji"`
+	// Value of the dice, 1-6 for “🎲”, “🎯” and “🎳” base emoji, 1-5 for “🏀” and “⚽” base emoji, 1-64 for “🎰” base emoji
+	Value int64 `json:"value"`
+}
+
+type BotCommand struct {
+	// Text of the command; 1-32 characters. Can contain only lowercase English letters, digits and underscores.
+	Command string `json:"command"`
+	// Description of the command; 1-256 characters.
+	Description string `json:"description"`
+}
+
+type ChatMemberUpdated struct {
+	// Chat the user belongs to
+	Chat *Chat `json:"chat"`
+	// Performer of the action, which resulted in the change
+	From *User `json:"from"`
+	// Date the change was done in Unix time
+	Date int64 `json:"date"`
+	// Previous information about the chat member
+	OldChatMember ChatMember `json:"old_chat_member"`
+	// New information about the chat member
+	NewChatMember ChatMember `json:"new_chat_member"`
+	// Optional. Chat invite link, which was used by the user to join the chat; for joining by invite link events only.
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+	// Optional. True, if the user joined the chat after sending a direct join request without using an invite link and being approved by an administrator
+	ViaJoinRequest *bool `json:"via_join_request,omitempty"`
+	// Optional. True, if the user joined the chat via a chat folder invite link
+	ViaChatFolderInviteLink *bool `json:"via_chat_folder_invite_link,omitempty"`
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *ChatMemberUpdated) UnmarshalJSON(b []byte) error {
+	type alias ChatMemberUpdated
+	aux := struct {
+		*alias
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.OldChatMember, err = unmarshalChatMember(aux.OldChatMember); err != nil {
+		return err
+	}
+	if v.NewChatMember, err = unmarshalChatMember(aux.NewChatMember); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MessageOrigin is one of its variant types, such as MessageOriginUser.
+type MessageOrigin interface {
+	messageOrigin()
+}
+
+func (*MessageOriginUser) messageOrigin()       {}
+func (*MessageOriginHiddenUser) messageOrigin() {}
+func (*MessageOriginChat) messageOrigin()       {}
+func (*MessageOriginChannel) messageOrigin()    {}
+
+// UnknownMessageOrigin is a MessageOrigin variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownMessageOrigin struct {
+	// Type is the type of the value.
+	Type string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownMessageOrigin) messageOrigin() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownMessageOrigin) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalMessageOrigin decodes b into the MessageOrigin variant named by its type field.
+// Unknown variants, from newer API versions, are decoded as *UnknownMessageOrigin.
+func unmarshalMessageOrigin(b json.RawMessage) (MessageOrigin, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v MessageOrigin
+	switch d.Value {
+	case "user":
+		v = new(MessageOriginUser)
+	case "hidden_user":
+		v = new(MessageOriginHiddenUser)
+	case "chat":
+		v = new(MessageOriginChat)
+	case "channel":
+		v = new(MessageOriginChannel)
+	default:
+		return &UnknownMessageOrigin{Type: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalMessageOriginList decodes each item of bs with unmarshalMessageOrigin.
+func unmarshalMessageOriginList(bs []json.RawMessage) ([]MessageOrigin, error) {
+	var list []MessageOrigin
+	for _, b := range bs {
+		v, err := unmarshalMessageOrigin(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type MessageOriginUser struct {
+	// Type of the message origin, always “user”
+	Type string `json:"type"`
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+	// User that sent the message originally
+	SenderUser *User `json:"sender_user"`
+}
+
+// MarshalJSON encodes v with type set to "user".
+func (v *MessageOriginUser) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginUser
+	a := alias(*v)
+	a.Type = "user"
+	return json.Marshal(&a)
+}
+
+type MessageOriginHiddenUser struct {
+	// Type of the message origin, always “hidden_user”
+	Type string `json:"type"`
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+	// Name of the user that sent the message originally
+	SenderUserName string `json:"sender_user_name"`
+}
+
+// MarshalJSON encodes v with type set to "hidden_user".
+func (v *MessageOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginHiddenUser
+	a := alias(*v)
+	a.Type = "hidden_user"
+	return json.Marshal(&a)
+}
+
+type MessageOriginChat struct {
+	// Type of the message origin, always “chat”
+	Type string `json:"type"`
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+	// Chat that sent the message originally
+	SenderChat *Chat `json:"sender_chat"`
+	// Optional. For messages originally sent by an anonymous chat administrator, original message author signature
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "chat".
+func (v *MessageOriginChat) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginChat
+	a := alias(*v)
+	a.Type = "chat"
+	return json.Marshal(&a)
+}
+
+type MessageOriginChannel struct {
+	// Type of the message origin, always “channel”
+	Type string `json:"type"`
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+	// Channel chat to which the message was originally sent
+	Chat *Chat `json:"chat"`
+	// Unique message identifier inside the chat
+	MessageId int64 `json:"message_id"`
+	// Optional. Signature of the original post author
+	AuthorSignature string `json:"author_signature,omitempty"`
+}
+
+// MarshalJSON encodes v with type set to "channel".
+func (v *MessageOriginChannel) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginChannel
+	a := alias(*v)
+	a.Type = "channel"
+	return json.Marshal(&a)
+}
+
+// BotCommandScope is one of its variant types, such as BotCommandScopeDefault.
+type BotCommandScope interface {
+	botCommandScope()
+}
+
+func (*BotCommandScopeDefault) botCommandScope()               {}
+func (*BotCommandScopeAllPrivateChats) botCommandScope()       {}
+func (*BotCommandScopeAllGroupChats) botCommandScope()         {}
+func (*BotCommandScopeAllChatAdministrators) botCommandScope() {}
+func (*BotCommandScopeChat) botCommandScope()                  {}
+func (*BotCommandScopeChatAdministrators) botCommandScope()    {}
+func (*BotCommandScopeChatMember) botCommandScope()            {}
+
+// UnknownBotCommandScope is a BotCommandScope variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownBotCommandScope struct {
+	// Type is the type of the value.
+	Type string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownBotCommandScope) botCommandScope() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownBotCommandScope) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalBotCommandScope decodes b into the BotCommandScope variant named by its type field.
+// Unknown variants, from newer API versions, are decoded as *UnknownBotCommandScope.
+func unmarshalBotCommandScope(b json.RawMessage) (BotCommandScope, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v BotCommandScope
+	switch d.Value {
+	case "default":
+		v = new(BotCommandScopeDefault)
+	case "all_private_chats":
+		v = new(BotCommandScopeAllPrivateChats)
+	case "all_group_chats":
+		v = new(BotCommandScopeAllGroupChats)
+	case "all_chat_administrators":
+		v = new(BotCommandScopeAllChatAdministrators)
+	case "chat":
+		v = new(BotCommandScopeChat)
+	case "chat_administrators":
+		v = new(BotCommandScopeChatAdministrators)
+	case "chat_member":
+		v = new(BotCommandScopeChatMember)
+	default:
+		return &UnknownBotCommandScope{Type: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalBotCommandScopeList decodes each item of bs with unmarshalBotCommandScope.
+func unmarshalBotCommandScopeList(bs []json.RawMessage) ([]BotCommandScope, error) {
+	var list []BotCommandScope
+	for _, b := range bs {
+		v, err := unmarshalBotCommandScope(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type BotCommandScopeDefault struct {
+	// Scope type, must be default
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes v with type set to "default".
+func (v *BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeDefault
+	a := alias(*v)
+	a.Type = "default"
+	return json.Marshal(&a)
+}
+
+type BotCommandScopeAllPrivateChats struct {
+	// Scope type, must be all_private_chats
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes v with type set to "all_private_chats".
+func (v *BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeAllPrivateChats
+	a := alias(*v)
+	a.Type = "all_private_chats"
+	return json.Marshal(&a)
+}
+
+type BotCommandScopeAllGroupChats struct {
+	// Scope type, must be all_group_chats
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes v with type set to "all_group_chats".
+func (v *BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeAllGroupChats
+	a := alias(*v)
+	a.Type = "all_group_chats"
+	return json.Marshal(&a)
+}
+
+type BotCommandScopeAllChatAdministrators struct {
+	// Scope type, must be all_chat_administrators
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes v with type set to "all_chat_administrators".
+func (v *BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeAllChatAdministrators
+	a := alias(*v)
+	a.Type = "all_chat_administrators"
+	return json.Marshal(&a)
+}
+
+type BotCommandScopeChat struct {
+	// Scope type, must be chat
+	Type string `json:"type"`
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// MarshalJSON encodes v with type set to "chat".
+func (v *BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeChat
+	a := alias(*v)
+	a.Type = "chat"
+	return json.Marshal(&a)
+}
+
+type BotCommandScopeChatAdministrators struct {
+	// Scope type, must be chat_administrators
+	Type string `json:"type"`
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// MarshalJSON encodes v with type set to "chat_administrators".
+func (v *BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeChatAdministrators
+	a := alias(*v)
+	a.Type = "chat_administrators"
+	return json.Marshal(&a)
+}
+
+type BotCommandScopeChatMember struct {
+	// Scope type, must be chat_member
+	Type string `json:"type"`
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+}
+
+// MarshalJSON encodes v with type set to "chat_member".
+func (v *BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeChatMember
+	a := alias(*v)
+	a.Type = "chat_member"
+	return json.Marshal(&a)
+}
+
+// ReactionType is one of its variant types, such as ReactionTypeEmoji.
+type ReactionType interface {
+	reactionType()
+}
+
+func (*ReactionTypeEmoji) reactionType()       {}
+func (*ReactionTypeCustomEmoji) reactionType() {}
+
+// UnknownReactionType is a ReactionType variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownReactionType struct {
+	// Type is the type of the value.
+	Type string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownReactionType) reactionType() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownReactionType) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalReactionType decodes b into the ReactionType variant named by its type field.
+// Unknown variants, from newer API versions, are decoded as *UnknownReactionType.
+func unmarshalReactionType(b json.RawMessage) (ReactionType, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v ReactionType
+	switch d.Value {
+	case "emoji":
+		v = new(ReactionTypeEmoji)
+	case "custom_emoji":
+		v = new(ReactionTypeCustomEmoji)
+	default:
+		return &UnknownReactionType{Type: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalReactionTypeList decodes each item of bs with unmarshalReactionType.
+func unmarshalReactionTypeList(bs []json.RawMessage) ([]ReactionType, error) {
+	var list []ReactionType
+	for _, b := range bs {
+		v, err := unmarshalReactionType(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type ReactionTypeEmoji struct {
+	// Type of the reaction, always “emoji”
+	Type string `json:"type"`
+	// Reaction emoji, from the list of reactions available in the chat
+	Emoji string `json:"emoji"`
+}
+
+// MarshalJSON encodes v with type set to "emoji".
+func (v *ReactionTypeEmoji) MarshalJSON() ([]byte, error) {
+	type alias ReactionTypeEmoji
+	a := alias(*v)
+	a.Type = "emoji"
+	return json.Marshal(&a)
+}
+
+type ReactionTypeCustomEmoji struct {
+	// Type of the reaction, always “custom_emoji”
+	Type string `json:"type"`
+	// Custom emoji identifier
+	CustomEmojiId string `json:"custom_emoji_id"`
+}
+
+// MarshalJSON encodes v with type set to "custom_emoji".
+func (v *ReactionTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	type alias ReactionTypeCustomEmoji
+	a := alias(*v)
+	a.Type = "custom_emoji"
+	return json.Marshal(&a)
+}
+
+type WebAppInfo struct {
+	// An HTTPS URL of a Web App to be opened with additional data as specified in Initializing Web Apps
+	Url string `json:"url"`
+}
+
+// MenuButton is one of its variant types, such as MenuButtonCommands.
+type MenuButton interface {
+	menuButton()
+}
+
+func (*MenuButtonCommands) menuButton() {}
+func (*MenuButtonWebApp) menuButton()   {}
+func (*MenuButtonDefault) menuButton()  {}
+
+// UnknownMenuButton is a MenuButton variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownMenuButton struct {
+	// Type is the type of the value.
+	Type string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownMenuButton) menuButton() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownMenuButton) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalMenuButton decodes b into the MenuButton variant named by its type field.
+// Unknown variants, from newer API versions, are decoded as *UnknownMenuButton.
+func unmarshalMenuButton(b json.RawMessage) (MenuButton, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v MenuButton
+	switch d.Value {
+	case "commands":
+		v = new(MenuButtonCommands)
+	case "web_app":
+		v = new(MenuButtonWebApp)
+	case "default":
+		v = new(MenuButtonDefault)
+	default:
+		return &UnknownMenuButton{Type: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalMenuButtonList decodes each item of bs with unmarshalMenuButton.
+func unmarshalMenuButtonList(bs []json.RawMessage) ([]MenuButton, error) {
+	var list []MenuButton
+	for _, b := range bs {
+		v, err := unmarshalMenuButton(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type MenuButtonCommands struct {
+	// Type of the button, must be commands
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes v with type set to "commands".
+func (v *MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonCommands
+	a := alias(*v)
+	a.Type = "commands"
+	return json.Marshal(&a)
+}
+
+type MenuButtonWebApp struct {
+	// Type of the button, must be web_app
+	Type string `json:"type"`
+	// Text on the button
+	Text string `json:"text"`
+	// Description of the Web App that will be launched when the user presses the button. The Web App will be able to send an arbitrary message on behalf of the user using the method answerWebAppQuery.
+	WebApp *WebAppInfo `json:"web_app"`
+}
+
+// MarshalJSON encodes v with type set to "web_app".
+func (v *MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonWebApp
+	a := alias(*v)
+	a.Type = "web_app"
+	return json.Marshal(&a)
+}
+
+type MenuButtonDefault struct {
+	// Type of the button, must be default
+	Type string `json:"type"`
+}
+
+// MarshalJSON encodes v with type set to "default".
+func (v *MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonDefault
+	a := alias(*v)
+	a.Type = "default"
+	return json.Marshal(&a)
+}
+
+// PollType is a value of the type field of Poll.
+type PollType string
+
+const (
+	PollTypeRegular PollType = "regular"
+	PollTypeQuiz    PollType = "quiz"
+)
+
+type Poll struct {
+	// Unique poll identifier
+	Id string `json:"id"`
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+	// Optional. Special entities that appear in the question. Currently, only custom emoji entities are allowed in poll questions
+	QuestionEntities []*MessageEntity `json:"question_entities,omitempty"`
+	// List of poll options
+	Options []*PollOption `json:"options"`
+	// Total number of users that voted in the poll
+	TotalVoterCount int64 `json:"total_voter_count"`
+	// True, if the poll is closed
+	IsClosed bool `json:"is_closed"`
+	// True, if the poll is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+	// Poll type, currently can be “regular” or “quiz”
+	Type PollType `json:"type"`
+	// True, if the poll allows multiple answers
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
+	// Optional. 0-based identifier of the correct answer option. Available only for polls in the quiz mode, which are closed, or was sent (not forwarded) by the bot or to the private chat with the bot.
+	CorrectOptionId *int64 `json:"correct_option_id,omitempty"`
+	// Optional. Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style poll, 0-200 characters
+	Explanation string `json:"explanation,omitempty"`
+	// Optional. Special entities like usernames, URLs, bot commands, etc. that appear in the explanation
+	ExplanationEntities []*MessageEntity `json:"explanation_entities,omitempty"`
+	// Optional. Amount of time in seconds the poll will be active after creation
+	OpenPeriod *int64 `json:"open_period,omitempty"`
+	// Optional. Point in time (Unix timestamp) when the poll will be automatically closed
+	CloseDate *int64 `json:"close_date,omitempty"`
+}
+
+type PollOption struct {
+	// Option text, 1-100 characters
+	Text string `json:"text"`
+	// Optional. Special entities that appear in the option text. Currently, only custom emoji entities are allowed in poll option texts
+	TextEntities []*MessageEntity `json:"text_entities,omitempty"`
+	// Number of users that voted for this option
+	VoterCount int64 `json:"voter_count"`
+}
+
+type InputPollOption struct {
+	// Option text, 1-100 characters
+	Text string `json:"text"`
+	// Optional. Mode for parsing entities in the text. See formatting options for more details. Currently, only custom emoji entities are allowed
+	TextParseMode ParseMode `json:"text_parse_mode,omitempty"`
+	// Optional. A JSON-serialized list of special entities that appear in the poll option text. It can be specified instead of text_parse_mode
+	TextEntities []*MessageEntity `json:"text_entities,omitempty"`
+}
+
+type ForumTopic struct {
+	// Unique identifier of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Name of the topic
+	Name string `json:"name"`
+	// Color of the topic icon in RGB format
+	IconColor int64 `json:"icon_color"`
+	// Optional. Unique identifier of the custom emoji shown as the topic icon
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+type ChatAdministratorRights struct {
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+	// True, if the administrator can access the chat event log, get boost list, see hidden supergroup and channel members, report spam messages and ignore slow mode. Implied by any other administrator privilege.
+	CanManageChat bool `json:"can_manage_chat"`
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	// True, if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members"`
+	// True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that they have promoted, directly or indirectly (promoted by administrators that were appointed by the user)
+	CanPromoteMembers bool `json:"can_promote_members"`
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// True, if the administrator can post stories to the chat
+	CanPostStories bool `json:"can_post_stories"`
+	// True, if the administrator can edit stories posted by other users, post stories to the chat page, pin chat stories, and access the chat's story archive
+	CanEditStories bool `json:"can_edit_stories"`
+	// True, if the administrator can delete stories posted by other users
+	CanDeleteStories bool `json:"can_delete_stories"`
+	// Optional. True, if the administrator can post messages in the channel, or access channel statistics; for channels only
+	CanPostMessages *bool `json:"can_post_messages,omitempty"`
+	// Optional. True, if the administrator can edit messages of other users and can pin messages; for channels only
+	CanEditMessages *bool `json:"can_edit_messages,omitempty"`
+	// Optional. True, if the user is allowed to pin messages; for groups and supergroups only
+	CanPinMessages *bool `json:"can_pin_messages,omitempty"`
+	// Optional. True, if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
+	CanManageTopics *bool `json:"can_manage_topics,omitempty"`
+}
+
+// ChatBoostSource is one of its variant types, such as ChatBoostSourcePremium.
+type ChatBoostSource interface {
+	chatBoostSource()
+}
+
+func (*ChatBoostSourcePremium) chatBoostSource()  {}
+func (*ChatBoostSourceGiftCode) chatBoostSource() {}
+func (*ChatBoostSourceGiveaway) chatBoostSource() {}
+
+// UnknownChatBoostSource is a ChatBoostSource variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownChatBoostSource struct {
+	// Source is the source of the value.
+	Source string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownChatBoostSource) chatBoostSource() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownChatBoostSource) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalChatBoostSource decodes b into the ChatBoostSource variant named by its source field.
+// Unknown variants, from newer API versions, are decoded as *UnknownChatBoostSource.
+func unmarshalChatBoostSource(b json.RawMessage) (ChatBoostSource, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"source"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v ChatBoostSource
+	switch d.Value {
+	case "premium":
+		v = new(ChatBoostSourcePremium)
+	case "gift_code":
+		v = new(ChatBoostSourceGiftCode)
+	case "giveaway":
+		v = new(ChatBoostSourceGiveaway)
+	default:
+		return &UnknownChatBoostSource{Source: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalChatBoostSourceList decodes each item of bs with unmarshalChatBoostSource.
+func unmarshalChatBoostSourceList(bs []json.RawMessage) ([]ChatBoostSource, error) {
+	var list []ChatBoostSource
+	for _, b := range bs {
+		v, err := unmarshalChatBoostSource(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+type ChatBoostSourcePremium struct {
+	// Source of the boost, always “premium”
+	Source string `json:"source"`
+	// User that boosted the chat
+	User *User `json:"user"`
+}
+
+// MarshalJSON encodes v with source set to "premium".
+func (v *ChatBoostSourcePremium) MarshalJSON() ([]byte, error) {
+	type alias ChatBoostSourcePremium
+	a := alias(*v)
+	a.Source = "premium"
+	return json.Marshal(&a)
+}
+
+type ChatBoostSourceGiftCode struct {
+	// Source of the boost, always “gift_code”
+	Source string `json:"source"`
+	// User for which the gift code was created
+	User *User `json:"user"`
+}
+
+// MarshalJSON encodes v with source set to "gift_code".
+func (v *ChatBoostSourceGiftCode) MarshalJSON() ([]byte, error) {
+	type alias ChatBoostSourceGiftCode
+	a := alias(*v)
+	a.Source = "gift_code"
+	return json.Marshal(&a)
+}
+
+type ChatBoostSourceGiveaway struct {
+	// Source of the boost, always “giveaway”
+	Source string `json:"source"`
+	// Identifier of a message in the chat with the giveaway; the message could have been deleted already. May be 0 if the message isn't sent yet.
+	GiveawayMessageId int64 `json:"giveaway_message_id"`
+	// Optional. User that won the prize in the giveaway if any
+	User *User `json:"user,omitempty"`
+	// Optional. True, if the giveaway was completed, but there was no user to win the prize
+	IsUnclaimed bool `json:"is_unclaimed,omitempty"`
+}
+
+// MarshalJSON encodes v with source set to "giveaway".
+func (v *ChatBoostSourceGiveaway) MarshalJSON() ([]byte, error) {
+	type alias ChatBoostSourceGiveaway
+	a := alias(*v)
+	a.Source = "giveaway"
+	return json.Marshal(&a)
+}
+
+type ChatBoost struct {
+	// Unique identifier of the boost
+	BoostId string `json:"boost_id"`
+	// Point in time (Unix timestamp) when the chat was boosted
+	AddDate int64 `json:"add_date"`
+	// Point in time (Unix timestamp) when the boost will automatically expire, unless the booster's Telegram Premium subscription is prolonged
+	ExpirationDate int64 `json:"expiration_date"`
+	// Source of the added boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// UnmarshalJSON decodes v, including the variants of its union fields.
+func (v *ChatBoost) UnmarshalJSON(b []byte) error {
+	type alias ChatBoost
+	aux := struct {
+		*alias
+		Source json.RawMessage `json:"source"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	var err error
+	if v.Source, err = unmarshalChatBoostSource(aux.Source); err != nil {
+		return err
+	}
+	return nil
+}
+
+type UserChatBoosts struct {
+	// The list of boosts added to the chat by the user
+	Boosts []*ChatBoost `json:"boosts"`
+}
+
+type BusinessConnection struct {
+	// Unique identifier of the business connection
+	Id string `json:"id"`
+	// Business account user that created the business connection
+	User *User `json:"user"`
+	// Identifier of a private chat with the user who created the business connection.
+	UserChatId int64 `json:"user_chat_id"`
+	// Date the connection was established in Unix time
+	Date int64 `json:"date"`
+	// True, if the bot can act on behalf of the business account in chats that were active in the last 24 hours
+	CanReply bool `json:"can_reply"`
+	// True, if the connection is active
+	IsEnabled bool `json:"is_enabled"`
+}
+
+type BotName struct {
+	// The bot's name
+	Name string `json:"name"`
+}
+
+type BotDescription struct {
+	// The bot's description
+	Description string `json:"description"`
+}
+
+type BotShortDescription struct {
+	// The bot's short description
+	ShortDescription string `json:"short_description"`
+}
+
+// StickerSetStickerType is a value of the sticker_type field of StickerSet.
+type StickerSetStickerType string
+
+const (
+	StickerSetStickerTypeRegular     StickerSetStickerType = "regular"
+	StickerSetStickerTypeMask        StickerSetStickerType = "mask"
+	StickerSetStickerTypeCustomEmoji StickerSetStickerType = "custom_emoji"
+)
+
+type StickerSet struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// Sticker set title
+	Title string `json:"title"`
+	// Type of stickers in the set, currently one of “regular”, “mask”, “custom_emoji”
+	StickerType StickerSetStickerType `json:"sticker_type"`
+	// List of all set stickers
+	Stickers []*Sticker `json:"stickers"`
+	// Optional. Sticker set thumbnail in the .WEBP, .TGS, or .WEBM format
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// MaskPositionPoint is a value of the point field of MaskPosition.
+type MaskPositionPoint string
+
+const (
+	MaskPositionPointForehead MaskPositionPoint = "forehead"
+	MaskPositionPointEyes     MaskPositionPoint = "eyes"
+	MaskPositionPointMouth    MaskPositionPoint = "mouth"
+	MaskPositionPointChin     MaskPositionPoint = "chin"
+)
+
+type MaskPosition struct {
+	// The part of the face relative to which the mask should be placed. One of “forehead”, “eyes”, “mouth”, or “chin”.
+	Point MaskPositionPoint `json:"point"`
+	// Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. For example, choosing -1.0 will place mask just to the left of the default mask position.
+	XShift float64 `json:"x_shift"`
+	// Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. For example, 1.0 will place the mask just below the default mask position.
+	YShift float64 `json:"y_shift"`
+	// Mask scaling coefficient. For example, 2.0 means double size.
+	Scale float64 `json:"scale"`
+}
+
+// InputStickerFormat is a value of the format field of InputSticker.
+type InputStickerFormat string
+
+const (
+	InputStickerFormatStatic   InputStickerFormat = "static"
+	InputStickerFormatAnimated InputStickerFormat = "animated"
+	InputStickerFormatVideo    InputStickerFormat = "video"
+)
+
+type InputSticker struct {
+	// The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. Animated and video stickers can't be uploaded via HTTP URL.
+	Sticker *InputFile `json:"sticker"`
+	// Format of the added sticker, must be one of “static” for a .WEBP or .PNG image, “animated” for a .TGS animation, “video” for a WEBM video
+	Format InputStickerFormat `json:"format"`
+	// List of 1-20 emoji associated with the sticker
+	EmojiList []string `json:"emoji_list"`
+	// Optional. Position where the mask should be placed on faces. For “mask” stickers only.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+	// Optional. List of 0-20 search keywords for the sticker with total length of up to 64 characters. For “regular” and “custom_emoji” stickers only.
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type SentWebAppMessage struct {
+	// Optional. Identifier of the sent inline message. Available only if there is an inline keyboard attached to the message.
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+}
+
+type LabeledPrice struct {
+	// Portion label
+	Label string `json:"label"`
+	// Price of the product in the smallest units of the currency (integer, not float/double). For example, for a price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json, it shows the number of digits past the decimal point for each currency (2 for the majority of currencies).
+	Amount int64 `json:"amount"`
+}
+
+type ShippingOption struct {
+	// Shipping option identifier
+	Id string `json:"id"`
+	// Option title
+	Title string `json:"title"`
+	// List of price portions
+	Prices []*LabeledPrice `json:"prices"`
+}
+
+// PassportElementError is one of its variant types, such as PassportElementErrorDataField.
+type PassportElementError interface {
+	passportElementError()
+}
+
+func (*PassportElementErrorDataField) passportElementError()        {}
+func (*PassportElementErrorFrontSide) passportElementError()        {}
+func (*PassportElementErrorReverseSide) passportElementError()      {}
+func (*PassportElementErrorSelfie) passportElementError()           {}
+func (*PassportElementErrorFile) passportElementError()             {}
+func (*PassportElementErrorFiles) passportElementError()            {}
+func (*PassportElementErrorTranslationFile) passportElementError()  {}
+func (*PassportElementErrorTranslationFiles) passportElementError() {}
+func (*PassportElementErrorUnspecified) passportElementError()      {}
+
+// UnknownPassportElementError is a PassportElementError variant not known by this package, such as
+// the ones added by newer API versions.
+type UnknownPassportElementError struct {
+	// Source is the source of the value.
+	Source string
+	// Raw is the JSON encoding of the value.
+	Raw json.RawMessage
+}
+
+func (*UnknownPassportElementError) passportElementError() {}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (v *UnknownPassportElementError) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// unmarshalPassportElementError decodes b into the PassportElementError variant named by its source field.
+// Unknown variants, from newer API versions, are decoded as *UnknownPassportElementError.
+func unmarshalPassportElementError(b json.RawMessage) (PassportElementError, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	var d struct {
+		Value string `json:"source"`
+	}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, err
+	}
+	var v PassportElementError
+	switch d.Value {
+	case "data":
+		v = new(PassportElementErrorDataField)
+	case "front_side":
+		v = new(PassportElementErrorFrontSide)
+	case "reverse_side":
+		v = new(PassportElementErrorReverseSide)
+	case "selfie":
+		v = new(PassportElementErrorSelfie)
+	case "file":
+		v = new(PassportElementErrorFile)
+	case "files":
+		v = new(PassportElementErrorFiles)
+	case "translation_file":
+		v = new(PassportElementErrorTranslationFile)
+	case "translation_files":
+		v = new(PassportElementErrorTranslationFiles)
+	case "unspecified":
+		v = new(PassportElementErrorUnspecified)
+	default:
+		return &UnknownPassportElementError{Source: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unmarshalPassportElementErrorList decodes each item of bs with unmarshalPassportElementError.
+func unmarshalPassportElementErrorList(bs []json.RawMessage) ([]PassportElementError, error) {
+	var list []PassportElementError
+	for _, b := range bs {
+		v, err := unmarshalPassportElementError(b)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+// PassportElementErrorDataFieldType is a value of the type field of PassportElementErrorDataField.
+type PassportElementErrorDataFieldType string
+
+const (
+	PassportElementErrorDataFieldTypePersonalDetails  PassportElementErrorDataFieldType = "personal_details"
+	PassportElementErrorDataFieldTypePassport         PassportElementErrorDataFieldType = "passport"
+	PassportElementErrorDataFieldTypeDriverLicense    PassportElementErrorDataFieldType = "driver_license"
+	PassportElementErrorDataFieldTypeIdentityCard     PassportElementErrorDataFieldType = "identity_card"
+	PassportElementErrorDataFieldTypeInternalPassport PassportElementErrorDataFieldType = "internal_passport"
+	PassportElementErrorDataFieldTypeAddress          PassportElementErrorDataFieldType = "address"
+)
+
+type PassportElementErrorDataField struct {
+	// Error source, must be data
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the error, one of “personal_details”, “passport”, “driver_license”, “identity_card”, “internal_passport”, “address”
+	Type PassportElementErrorDataFieldType `json:"type"`
+	// Name of the data field which has the error
+	FieldName string `json:"field_name"`
+	// Base64-encoded data hash
+	DataHash string `json:"data_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "data".
+func (v *PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorDataField
+	a := alias(*v)
+	a.Source = "data"
+	return json.Marshal(&a)
+}
+
+// PassportElementErrorFrontSideType is a value of the type field of PassportElementErrorFrontSide.
+type PassportElementErrorFrontSideType string
+
+const (
+	PassportElementErrorFrontSideTypePassport         PassportElementErrorFrontSideType = "passport"
+	PassportElementErrorFrontSideTypeDriverLicense    PassportElementErrorFrontSideType = "driver_license"
+	PassportElementErrorFrontSideTypeIdentityCard     PassportElementErrorFrontSideType = "identity_card"
+	PassportElementErrorFrontSideTypeInternalPassport PassportElementErrorFrontSideType = "internal_passport"
+)
+
+type PassportElementErrorFrontSide struct {
+	// Error source, must be front_side
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”
+	Type PassportElementErrorFrontSideType `json:"type"`
+	// Base64-encoded hash of the file with the front side of the document
+	FileHash string `json:"file_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "front_side".
+func (v *PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFrontSide
+	a := alias(*v)
+	a.Source = "front_side"
+	return json.Marshal(&a)
+}
+
+// PassportElementErrorReverseSideType is a value of the type field of PassportElementErrorReverseSide.
+type PassportElementErrorReverseSideType string
+
+const (
+	PassportElementErrorReverseSideTypeDriverLicense PassportElementErrorReverseSideType = "driver_license"
+	PassportElementErrorReverseSideTypeIdentityCard  PassportElementErrorReverseSideType = "identity_card"
+)
+
+type PassportElementErrorReverseSide struct {
+	// Error source, must be reverse_side
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the issue, one of “driver_license”, “identity_card”
+	Type PassportElementErrorReverseSideType `json:"type"`
+	// Base64-encoded hash of the file with the reverse side of the document
+	FileHash string `json:"file_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "reverse_side".
+func (v *PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorReverseSide
+	a := alias(*v)
+	a.Source = "reverse_side"
+	return json.Marshal(&a)
+}
+
+type PassportElementErrorSelfie struct {
+	// Error source, must be selfie
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”
+	Type PassportElementErrorFrontSideType `json:"type"`
+	// Base64-encoded hash of the file with the selfie
+	FileHash string `json:"file_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "selfie".
+func (v *PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSelfie
+	a := alias(*v)
+	a.Source = "selfie"
+	return json.Marshal(&a)
+}
+
+// PassportElementErrorFileType is a value of the type field of PassportElementErrorFile.
+type PassportElementErrorFileType string
+
+const (
+	PassportElementErrorFileTypeUtilityBill           PassportElementErrorFileType = "utility_bill"
+	PassportElementErrorFileTypeBankStatement         PassportElementErrorFileType = "bank_statement"
+	PassportElementErrorFileTypeRentalAgreement       PassportElementErrorFileType = "rental_agreement"
+	PassportElementErrorFileTypePassportRegistration  PassportElementErrorFileType = "passport_registration"
+	PassportElementErrorFileTypeTemporaryRegistration PassportElementErrorFileType = "temporary_registration"
+)
+
+type PassportElementErrorFile struct {
+	// Error source, must be file
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the issue, one of “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”
+	Type PassportElementErrorFileType `json:"type"`
+	// Base64-encoded file hash
+	FileHash string `json:"file_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "file".
+func (v *PassportElementErrorFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFile
+	a := alias(*v)
+	a.Source = "file"
+	return json.Marshal(&a)
+}
+
+type PassportElementErrorFiles struct {
+	// Error source, must be files
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the issue, one of “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”
+	Type PassportElementErrorFileType `json:"type"`
+	// List of base64-encoded file hashes
+	FileHashes []string `json:"file_hashes"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "files".
+func (v *PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFiles
+	a := alias(*v)
+	a.Source = "files"
+	return json.Marshal(&a)
+}
+
+// PassportElementErrorTranslationFileType is a value of the type field of PassportElementErrorTranslationFile.
+type PassportElementErrorTranslationFileType string
+
+const (
+	PassportElementErrorTranslationFileTypePassport              PassportElementErrorTranslationFileType = "passport"
+	PassportElementErrorTranslationFileTypeDriverLicense         PassportElementErrorTranslationFileType = "driver_license"
+	PassportElementErrorTranslationFileTypeIdentityCard          PassportElementErrorTranslationFileType = "identity_card"
+	PassportElementErrorTranslationFileTypeInternalPassport      PassportElementErrorTranslationFileType = "internal_passport"
+	PassportElementErrorTranslationFileTypeUtilityBill           PassportElementErrorTranslationFileType = "utility_bill"
+	PassportElementErrorTranslationFileTypeBankStatement         PassportElementErrorTranslationFileType = "bank_statement"
+	PassportElementErrorTranslationFileTypeRentalAgreement       PassportElementErrorTranslationFileType = "rental_agreement"
+	PassportElementErrorTranslationFileTypePassportRegistration  PassportElementErrorTranslationFileType = "passport_registration"
+	PassportElementErrorTranslationFileTypeTemporaryRegistration PassportElementErrorTranslationFileType = "temporary_registration"
+)
+
+type PassportElementErrorTranslationFile struct {
+	// Error source, must be translation_file
+	Source string `json:"source"`
+	// Type of element of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”, “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”
+	Type PassportElementErrorTranslationFileType `json:"type"`
+	// Base64-encoded file hash
+	FileHash string `json:"file_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "translation_file".
+func (v *PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorTranslationFile
+	a := alias(*v)
+	a.Source = "translation_file"
+	return json.Marshal(&a)
+}
+
+type PassportElementErrorTranslationFiles struct {
+	// Error source, must be translation_files
+	Source string `json:"source"`
+	// Type of element of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”, “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”
+	Type PassportElementErrorTranslationFileType `json:"type"`
+	// List of base64-encoded file hashes
+	FileHashes []string `json:"file_hashes"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "translation_files".
+func (v *PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorTranslationFiles
+	a := alias(*v)
+	a.Source = "translation_files"
+	return json.Marshal(&a)
+}
+
+type PassportElementErrorUnspecified struct {
+	// Error source, must be unspecified
+	Source string `json:"source"`
+	// Type of element of the user's Telegram Passport which has the issue
+	Type string `json:"type"`
+	// Base64-encoded element hash
+	ElementHash string `json:"element_hash"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// MarshalJSON encodes v with source set to "unspecified".
+func (v *PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorUnspecified
+	a := alias(*v)
+	a.Source = "unspecified"
+	return json.Marshal(&a)
+}
+
+// GetMe returns basic information about the bot.
+func (t *ApiClient) GetMe() (*User, error) {
+	return t.GetMeContext(context.Background())
+}
+
+// GetMeContext is like GetMe, but the request is bound to ctx.
+func (t *ApiClient) GetMeContext(ctx context.Context) (*User, error) {
+	out := new(User)
+	if err := t.CallContext(ctx, "POST", "getMe", nil, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LogOut logs out from the cloud Bot API server before launching the bot locally.
+func (t *ApiClient) LogOut() error {
+	return t.LogOutContext(context.Background())
+}
+
+// LogOutContext is like LogOut, but the request is bound to ctx.
+func (t *ApiClient) LogOutContext(ctx context.Context) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "logOut", nil, &ok)
+}
+
+// SendChatActionParams are the parameters of the sendChatAction method.
+type SendChatActionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for text messages, upload_photo for photos, record_video or upload_video for videos, record_voice or upload_voice for voice notes, upload_document for general files, choose_sticker for stickers, find_location for location data, record_video_note or upload_video_note for video notes.
+	Action string `json:"action"`
+}
+
+// SendChatAction tells the user that something is happening on the bot's side. The status is set for 5 seconds or less.
+func (t *ApiClient) SendChatAction(p *SendChatActionParams) error {
+	return t.SendChatActionContext(context.Background(), p)
+}
+
+// SendChatActionContext is like SendChatAction, but the request is bound to ctx.
+func (t *ApiClient) SendChatActionContext(ctx context.Context, p *SendChatActionParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "sendChatAction", p, &ok)
+}
+
+// SendLocationParams are the parameters of the sendLocation method.
+type SendLocationParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Latitude of the location
+	Latitude float64 `json:"latitude"`
+	// Longitude of the location
+	Longitude float64 `json:"longitude"`
+	// Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy *float64 `json:"horizontal_accuracy,omitempty"`
+	// Optional. Period in seconds during which the location will be updated, should be between 60 and 86400, or 0x7FFFFFFF for live locations that can be edited indefinitely.
+	LivePeriod *int64 `json:"live_period,omitempty"`
+	// Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading *int64 `json:"heading,omitempty"`
+	// Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius *int64 `json:"proximity_alert_radius,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove a reply keyboard or to force a reply from the user
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the sendLocation method.
+func (p *SendLocationParams) Validate() error {
+	var v validator
+	if p.HorizontalAccuracy != nil {
+		v.floatRange("horizontal_accuracy", *p.HorizontalAccuracy, 0, 1500)
+	}
+	if p.Heading != nil {
+		v.intRange("heading", *p.Heading, 1, 360)
+	}
+	if p.ProximityAlertRadius != nil {
+		v.intRange("proximity_alert_radius", *p.ProximityAlertRadius, 1, 100000)
+	}
+	return v.err()
+}
+
+// SendLocation sends a point on the map.
+func (t *ApiClient) SendLocation(p *SendLocationParams) (*Message, error) {
+	return t.SendLocationContext(context.Background(), p)
+}
+
+// SendLocationContext is like SendLocation, but the request is bound to ctx.
+func (t *ApiClient) SendLocationContext(ctx context.Context, p *SendLocationParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendLocation", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendVenueParams are the parameters of the sendVenue method.
+type SendVenueParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Latitude of the venue
+	Latitude float64 `json:"latitude"`
+	// Longitude of the venue
+	Longitude float64 `json:"longitude"`
+	// Name of the venue
+	Title string `json:"title"`
+	// Address of the venue
+	Address string `json:"address"`
+	// Optional. Foursquare identifier of the venue
+	FoursquareId string `json:"foursquare_id,omitempty"`
+	// Optional. Foursquare type of the venue, if known. (For example, “arts_entertainment/default”, “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Optional. Google Places identifier of the venue
+	GooglePlaceId string `json:"google_place_id,omitempty"`
+	// Optional. Google Places type of the venue.
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove a reply keyboard or to force a reply from the user
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendVenue sends information about a venue.
+func (t *ApiClient) SendVenue(p *SendVenueParams) (*Message, error) {
+	return t.SendVenueContext(context.Background(), p)
+}
+
+// SendVenueContext is like SendVenue, but the request is bound to ctx.
+func (t *ApiClient) SendVenueContext(ctx context.Context, p *SendVenueParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendVenue", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendContactParams are the parameters of the sendContact method.
+type SendContactParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+	// Contact's first name
+	FirstName string `json:"first_name"`
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove a reply keyboard or to force a reply from the user
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the sendContact method.
+func (p *SendContactParams) Validate() error {
+	var v validator
+	v.bytes("vcard", p.Vcard, 0, 2048)
+	return v.err()
+}
+
+// SendContact sends a phone contact.
+func (t *ApiClient) SendContact(p *SendContactParams) (*Message, error) {
+	return t.SendContactContext(context.Background(), p)
+}
+
+// SendContactContext is like SendContact, but the request is bound to ctx.
+func (t *ApiClient) SendContactContext(ctx context.Context, p *SendContactParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendContact", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendDiceParams are the parameters of the sendDice method.
+type SendDiceParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Optional. Emoji on which the dice throw animation is based. Currently, must be one of “🎲”, “🎯”, “🏀”, “⚽”, “🎳”, or “🎰”. Dice can have values 1-6 for “🎲”, “🎯” and “🎳”, values 1-5 for “🏀” and “⚽”, and values 1-64 for “🎰”. Defaults to “🎲”
+	Emoji string `json:"emoji,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove a reply keyboard or to force a reply from the user
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendDice sends an animated emoji that will display a random value.
+func (t *ApiClient) SendDice(p *SendDiceParams) (*Message, error) {
+	return t.SendDiceContext(context.Background(), p)
+}
+
+// SendDiceContext is like SendDice, but the request is bound to ctx.
+func (t *ApiClient) SendDiceContext(ctx context.Context, p *SendDiceParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendDice", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetUserProfilePhotosParams are the parameters of the getUserProfilePhotos method.
+type GetUserProfilePhotosParams struct {
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+	// Optional. Sequential number of the first photo to be returned. By default, all photos are returned.
+	Offset *int64 `json:"offset,omitempty"`
+	// Optional. Limits the number of photos to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the getUserProfilePhotos method.
+func (p *GetUserProfilePhotosParams) Validate() error {
+	var v validator
+	if p.Limit != nil {
+		v.intRange("limit", *p.Limit, 1, 100)
+	}
+	return v.err()
+}
+
+// GetUserProfilePhotos returns the profile pictures of a user.
+func (t *ApiClient) GetUserProfilePhotos(p *GetUserProfilePhotosParams) (*UserProfilePhotos, error) {
+	return t.GetUserProfilePhotosContext(context.Background(), p)
+}
+
+// GetUserProfilePhotosContext is like GetUserProfilePhotos, but the request is bound to ctx.
+func (t *ApiClient) GetUserProfilePhotosContext(ctx context.Context, p *GetUserProfilePhotosParams) (*UserProfilePhotos, error) {
+	out := new(UserProfilePhotos)
+	if err := t.CallContext(ctx, "POST", "getUserProfilePhotos", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetMyCommandsParams are the parameters of the setMyCommands method.
+type SetMyCommandsParams struct {
+	// A list of bot commands to be set as the list of the bot's commands. At most 100 commands can be specified.
+	Commands []*BotCommand `json:"commands"`
+	// Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope `json:"scope,omitempty"`
+	// Optional. A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the setMyCommands method.
+func (p *SetMyCommandsParams) Validate() error {
+	var v validator
+	v.items("commands", len(p.Commands), 100)
+	return v.err()
+}
+
+// SetMyCommands changes the list of the bot's commands.
+func (t *ApiClient) SetMyCommands(p *SetMyCommandsParams) error {
+	return t.SetMyCommandsContext(context.Background(), p)
+}
+
+// SetMyCommandsContext is like SetMyCommands, but the request is bound to ctx.
+func (t *ApiClient) SetMyCommandsContext(ctx context.Context, p *SetMyCommandsParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setMyCommands", p, &ok)
+}
+
+// GetMyCommandsParams are the parameters of the getMyCommands method.
+type GetMyCommandsParams struct {
+	// Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope `json:"scope,omitempty"`
+	// Optional. A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyCommands returns the current list of the bot's commands for the given language.
+func (t *ApiClient) GetMyCommands(p *GetMyCommandsParams) ([]*BotCommand, error) {
+	return t.GetMyCommandsContext(context.Background(), p)
+}
+
+// GetMyCommandsContext is like GetMyCommands, but the request is bound to ctx.
+func (t *ApiClient) GetMyCommandsContext(ctx context.Context, p *GetMyCommandsParams) ([]*BotCommand, error) {
+	var out []*BotCommand
+	if err := t.CallContext(ctx, "POST", "getMyCommands", p, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteMyCommandsParams are the parameters of the deleteMyCommands method.
+type DeleteMyCommandsParams struct {
+	// Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope `json:"scope,omitempty"`
+	// Optional. A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// DeleteMyCommands deletes the list of the bot's commands for the given language. Users will see the commands of the next broader scope.
+func (t *ApiClient) DeleteMyCommands(p *DeleteMyCommandsParams) error {
+	return t.DeleteMyCommandsContext(context.Background(), p)
+}
+
+// DeleteMyCommandsContext is like DeleteMyCommands, but the request is bound to ctx.
+func (t *ApiClient) DeleteMyCommandsContext(ctx context.Context, p *DeleteMyCommandsParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteMyCommands", p, &ok)
+}
+
+// UploadStickerFileParams are the parameters of the uploadStickerFile method.
+type UploadStickerFileParams struct {
+	// User identifier of sticker file owner
+	UserId int64 `json:"user_id"`
+	// A file with the sticker in .WEBP, .PNG, .TGS, or .WEBM format.
+	Sticker *InputFile `json:"sticker"`
+	// Format of the sticker, must be one of “static”, “animated”, “video”
+	StickerFormat string `json:"sticker_format"`
+}
+
+// UploadStickerFile uploads a file with a sticker for later use in sticker sets.
+func (t *ApiClient) UploadStickerFile(p *UploadStickerFileParams) (*File, error) {
+	return t.UploadStickerFileContext(context.Background(), p)
+}
+
+// UploadStickerFileContext is like UploadStickerFile, but the request is bound to ctx.
+func (t *ApiClient) UploadStickerFileContext(ctx context.Context, p *UploadStickerFileParams) (*File, error) {
+	out := new(File)
+	if err := t.callUpload(ctx, "uploadStickerFile", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendGameParams are the parameters of the sendGame method.
+type SendGameParams struct {
+	// Unique identifier for the target chat
+	ChatId int64 `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Short name of the game, serves as the unique identifier for the game. Set up your games via @BotFather.
+	GameShortName string `json:"game_short_name"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. A JSON-serialized object for an inline keyboard. If empty, one 'Play game_title' button will be shown. If not empty, the first button must launch the game.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendGame sends a game.
+func (t *ApiClient) SendGame(p *SendGameParams) (*Message, error) {
+	return t.SendGameContext(context.Background(), p)
+}
+
+// SendGameContext is like SendGame, but the request is bound to ctx.
+func (t *ApiClient) SendGameContext(ctx context.Context, p *SendGameParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendGame", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetGameScoreParams are the parameters of the setGameScore method.
+type SetGameScoreParams struct {
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// New score, must be non-negative
+	Score int64 `json:"score"`
+	// Optional. Pass True if the high score is allowed to decrease. This can be useful when fixing mistakes or banning cheaters
+	Force *bool `json:"force,omitempty"`
+	// Optional. Pass True if the game message should not be automatically edited to include the current scoreboard
+	DisableEditMessage *bool `json:"disable_edit_message,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat
+	ChatId *int64 `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the sent message
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+}
+
+// SetGameScore sets the score of a user in a game. The edited Message is returned, or nil for inline messages.
+func (t *ApiClient) SetGameScore(p *SetGameScoreParams) (*Message, error) {
+	return t.SetGameScoreContext(context.Background(), p)
+}
+
+// SetGameScoreContext is like SetGameScore, but the request is bound to ctx.
+func (t *ApiClient) SetGameScoreContext(ctx context.Context, p *SetGameScoreParams) (*Message, error) {
+	var result json.RawMessage
+	if err := t.CallContext(ctx, "POST", "setGameScore", p, &result); err != nil {
+		return nil, err
+	}
+	return editResult(result)
+}
+
+// GetGameHighScoresParams are the parameters of the getGameHighScores method.
+type GetGameHighScoresParams struct {
+	// Target user id
+	UserId int64 `json:"user_id"`
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat
+	ChatId *int64 `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the sent message
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+}
+
+// GetGameHighScores returns the score of the specified user and several of their neighbors in a game.
+func (t *ApiClient) GetGameHighScores(p *GetGameHighScoresParams) ([]*GameHighScore, error) {
+	return t.GetGameHighScoresContext(context.Background(), p)
+}
+
+// GetGameHighScoresContext is like GetGameHighScores, but the request is bound to ctx.
+func (t *ApiClient) GetGameHighScoresContext(ctx context.Context, p *GetGameHighScoresParams) ([]*GameHighScore, error) {
+	var out []*GameHighScore
+	if err := t.CallContext(ctx, "POST", "getGameHighScores", p, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Close closes the bot instance before moving it from one local server to another. Don't use it within 10 minutes after the bot is launched.
+func (t *ApiClient) Close() error {
+	return t.CloseContext(context.Background())
+}
+
+// CloseContext is like Close, but the request is bound to ctx.
+func (t *ApiClient) CloseContext(ctx context.Context) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "close", nil, &ok)
+}
+
+// SendMediaGroupParams are the parameters of the sendMediaGroup method.
+type SendMediaGroupParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// A JSON-serialized array describing messages to be sent, must include 2-10 items
+	Media []InputMedia `json:"media"`
+	// Optional. Sends messages silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent messages from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+}
+
+// SendMediaGroup sends a group of photos, videos, documents or audios as an album. Documents and audio files can be only grouped in an album with messages of the same type.
+func (t *ApiClient) SendMediaGroup(p *SendMediaGroupParams) ([]*Message, error) {
+	return t.SendMediaGroupContext(context.Background(), p)
+}
+
+// SendMediaGroupContext is like SendMediaGroup, but the request is bound to ctx.
+func (t *ApiClient) SendMediaGroupContext(ctx context.Context, p *SendMediaGroupParams) ([]*Message, error) {
+	var out []*Message
+	if err := t.callUpload(ctx, "sendMediaGroup", p, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendPollParams are the parameters of the sendPoll method.
+type SendPollParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+	// Optional. Mode for parsing entities in the question. See formatting options for more details. Currently, only custom emoji entities are allowed
+	QuestionParseMode ParseMode `json:"question_parse_mode,omitempty"`
+	// Optional. A JSON-serialized list of special entities that appear in the poll question. It can be specified instead of question_parse_mode
+	QuestionEntities []*MessageEntity `json:"question_entities,omitempty"`
+	// A JSON-serialized list of 2-10 answer options
+	Options []*InputPollOption `json:"options"`
+	// Optional. True, if the poll needs to be anonymous, defaults to True
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
+	// Optional. Poll type, “quiz” or “regular”, defaults to “regular”
+	Type string `json:"type,omitempty"`
+	// Optional. True, if the poll allows multiple answers, ignored for polls in quiz mode, defaults to False
+	AllowsMultipleAnswers *bool `json:"allows_multiple_answers,omitempty"`
+	// Optional. 0-based identifier of the correct answer option, required for polls in quiz mode
+	CorrectOptionId *int64 `json:"correct_option_id,omitempty"`
+	// Optional. Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style poll, 0-200 characters with at most 2 line feeds after entities parsing
+	Explanation string `json:"explanation,omitempty"`
+	// Optional. Mode for parsing entities in the explanation. See formatting options for more details.
+	ExplanationParseMode ParseMode `json:"explanation_parse_mode,omitempty"`
+	// Optional. A JSON-serialized list of special entities that appear in the poll explanation. It can be specified instead of explanation_parse_mode
+	ExplanationEntities []*MessageEntity `json:"explanation_entities,omitempty"`
+	// Optional. Amount of time in seconds the poll will be active after creation, 5-600. Can't be used together with close_date.
+	OpenPeriod *int64 `json:"open_period,omitempty"`
+	// Optional. Point in time (Unix timestamp) when the poll will be automatically closed. Must be at least 5 and no more than 600 seconds in the future. Can't be used together with open_period.
+	CloseDate *int64 `json:"close_date,omitempty"`
+	// Optional. Pass True if the poll needs to be immediately closed. This can be useful for poll preview.
+	IsClosed *bool `json:"is_closed,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove a reply keyboard or to force a reply from the user
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the sendPoll method.
+func (p *SendPollParams) Validate() error {
+	var v validator
+	v.chars("question", p.Question, 1, 300)
+	v.formatted("explanation", p.Explanation, 0, 200, p.ExplanationParseMode != "")
+	return v.err()
+}
+
+// SendPoll sends a native poll.
+func (t *ApiClient) SendPoll(p *SendPollParams) (*Message, error) {
+	return t.SendPollContext(context.Background(), p)
+}
+
+// SendPollContext is like SendPoll, but the request is bound to ctx.
+func (t *ApiClient) SendPollContext(ctx context.Context, p *SendPollParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendPoll", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetMessageReactionParams are the parameters of the setMessageReaction method.
+type SetMessageReactionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Identifier of the target message. If the message belongs to a media group, the reaction is set to the first non-deleted message in the group instead.
+	MessageId int64 `json:"message_id"`
+	// Optional. A JSON-serialized list of reaction types to set on the message. Currently, as non-premium users, bots can set up to one reaction per message. A custom emoji reaction can be used if it is either already present on the message or explicitly allowed by chat administrators.
+	Reaction []ReactionType `json:"reaction,omitempty"`
+	// Optional. Pass True to set the reaction with a big animation
+	IsBig *bool `json:"is_big,omitempty"`
+}
+
+// SetMessageReaction changes the chosen reactions on a message. Service messages can't be reacted to. Automatically forwarded messages from a channel to its discussion group have the same available reactions as messages in the channel.
+func (t *ApiClient) SetMessageReaction(p *SetMessageReactionParams) error {
+	return t.SetMessageReactionContext(context.Background(), p)
+}
+
+// SetMessageReactionContext is like SetMessageReaction, but the request is bound to ctx.
+func (t *ApiClient) SetMessageReactionContext(ctx context.Context, p *SetMessageReactionParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setMessageReaction", p, &ok)
+}
+
+// BanChatSenderChatParams are the parameters of the banChatSenderChat method.
+type BanChatSenderChatParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target sender chat
+	SenderChatId int64 `json:"sender_chat_id"`
+}
+
+// BanChatSenderChat bans a channel chat in a supergroup or a channel. Until the chat is unbanned, the owner of the banned chat won't be able to send messages on behalf of any of their channels. The bot must be an administrator in the supergroup or channel for this to work and must have the appropriate administrator rights.
+func (t *ApiClient) BanChatSenderChat(p *BanChatSenderChatParams) error {
+	return t.BanChatSenderChatContext(context.Background(), p)
+}
+
+// BanChatSenderChatContext is like BanChatSenderChat, but the request is bound to ctx.
+func (t *ApiClient) BanChatSenderChatContext(ctx context.Context, p *BanChatSenderChatParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "banChatSenderChat", p, &ok)
+}
+
+// UnbanChatSenderChatParams are the parameters of the unbanChatSenderChat method.
+type UnbanChatSenderChatParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target sender chat
+	SenderChatId int64 `json:"sender_chat_id"`
+}
+
+// UnbanChatSenderChat unbans a previously banned channel chat in a supergroup or channel. The bot must be an administrator for this to work and must have the appropriate administrator rights.
+func (t *ApiClient) UnbanChatSenderChat(p *UnbanChatSenderChatParams) error {
+	return t.UnbanChatSenderChatContext(context.Background(), p)
+}
+
+// UnbanChatSenderChatContext is like UnbanChatSenderChat, but the request is bound to ctx.
+func (t *ApiClient) UnbanChatSenderChatContext(ctx context.Context, p *UnbanChatSenderChatParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "unbanChatSenderChat", p, &ok)
+}
+
+// SetChatPermissionsParams are the parameters of the setChatPermissions method.
+type SetChatPermissionsParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// A JSON-serialized object for new default chat permissions
+	Permissions *ChatPermissions `json:"permissions"`
+	// Optional. Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios, can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UseIndependentChatPermissions *bool `json:"use_independent_chat_permissions,omitempty"`
+}
+
+// SetChatPermissions sets default chat permissions for all members. The bot must be an administrator in the group or a supergroup for this to work and must have the can_restrict_members administrator rights.
+func (t *ApiClient) SetChatPermissions(p *SetChatPermissionsParams) error {
+	return t.SetChatPermissionsContext(context.Background(), p)
+}
+
+// SetChatPermissionsContext is like SetChatPermissions, but the request is bound to ctx.
+func (t *ApiClient) SetChatPermissionsContext(ctx context.Context, p *SetChatPermissionsParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setChatPermissions", p, &ok)
+}
+
+// SetChatStickerSetParams are the parameters of the setChatStickerSet method.
+type SetChatStickerSetParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Name of the sticker set to be set as the group sticker set
+	StickerSetName string `json:"sticker_set_name"`
+}
+
+// SetChatStickerSet sets a new group sticker set for a supergroup. The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights. Use the field can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method.
+func (t *ApiClient) SetChatStickerSet(p *SetChatStickerSetParams) error {
+	return t.SetChatStickerSetContext(context.Background(), p)
+}
+
+// SetChatStickerSetContext is like SetChatStickerSet, but the request is bound to ctx.
+func (t *ApiClient) SetChatStickerSetContext(ctx context.Context, p *SetChatStickerSetParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setChatStickerSet", p, &ok)
+}
+
+// DeleteChatStickerSetParams are the parameters of the deleteChatStickerSet method.
+type DeleteChatStickerSetParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// DeleteChatStickerSet deletes a group sticker set from a supergroup. The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+func (t *ApiClient) DeleteChatStickerSet(p *DeleteChatStickerSetParams) error {
+	return t.DeleteChatStickerSetContext(context.Background(), p)
+}
+
+// DeleteChatStickerSetContext is like DeleteChatStickerSet, but the request is bound to ctx.
+func (t *ApiClient) DeleteChatStickerSetContext(ctx context.Context, p *DeleteChatStickerSetParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteChatStickerSet", p, &ok)
+}
+
+// GetForumTopicIconStickers returns the custom emoji stickers, which can be used as a forum topic icon by any user.
+func (t *ApiClient) GetForumTopicIconStickers() ([]*Sticker, error) {
+	return t.GetForumTopicIconStickersContext(context.Background())
+}
+
+// GetForumTopicIconStickersContext is like GetForumTopicIconStickers, but the request is bound to ctx.
+func (t *ApiClient) GetForumTopicIconStickersContext(ctx context.Context) ([]*Sticker, error) {
+	var out []*Sticker
+	if err := t.CallContext(ctx, "POST", "getForumTopicIconStickers", nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateForumTopicParams are the parameters of the createForumTopic method.
+type CreateForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Topic name, 1-128 characters
+	Name string `json:"name"`
+	// Optional. Color of the topic icon in RGB format. Currently, must be one of 7322096 (0x6FB9F0), 16766590 (0xFFD67E), 13338331 (0xCB86DB), 9367192 (0x8EEE98), 16749490 (0xFF93B2), or 16478047 (0xFB6F5F)
+	IconColor *int64 `json:"icon_color,omitempty"`
+	// Optional. Unique identifier of the custom emoji shown as the topic icon. Use getForumTopicIconStickers to get all allowed custom emoji identifiers.
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the createForumTopic method.
+func (p *CreateForumTopicParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 1, 128)
+	return v.err()
+}
+
+// CreateForumTopic creates a topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+func (t *ApiClient) CreateForumTopic(p *CreateForumTopicParams) (*ForumTopic, error) {
+	return t.CreateForumTopicContext(context.Background(), p)
+}
+
+// CreateForumTopicContext is like CreateForumTopic, but the request is bound to ctx.
+func (t *ApiClient) CreateForumTopicContext(ctx context.Context, p *CreateForumTopicParams) (*ForumTopic, error) {
+	out := new(ForumTopic)
+	if err := t.CallContext(ctx, "POST", "createForumTopic", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EditForumTopicParams are the parameters of the editForumTopic method.
+type EditForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+	// Optional. New topic name, 0-128 characters. If not specified or empty, the current name of the topic will be kept
+	Name string `json:"name,omitempty"`
+	// Optional. New unique identifier of the custom emoji shown as the topic icon. Use getForumTopicIconStickers to get all allowed custom emoji identifiers. Pass an empty string to remove the icon. If not specified, the current icon will be kept
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the editForumTopic method.
+func (p *EditForumTopicParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 0, 128)
+	return v.err()
+}
+
+// EditForumTopic edits name and icon of a topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
+func (t *ApiClient) EditForumTopic(p *EditForumTopicParams) error {
+	return t.EditForumTopicContext(context.Background(), p)
+}
+
+// EditForumTopicContext is like EditForumTopic, but the request is bound to ctx.
+func (t *ApiClient) EditForumTopicContext(ctx context.Context, p *EditForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "editForumTopic", p, &ok)
+}
+
+// CloseForumTopicParams are the parameters of the closeForumTopic method.
+type CloseForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+// CloseForumTopic closes an open topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
+func (t *ApiClient) CloseForumTopic(p *CloseForumTopicParams) error {
+	return t.CloseForumTopicContext(context.Background(), p)
+}
+
+// CloseForumTopicContext is like CloseForumTopic, but the request is bound to ctx.
+func (t *ApiClient) CloseForumTopicContext(ctx context.Context, p *CloseForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "closeForumTopic", p, &ok)
+}
+
+// ReopenForumTopicParams are the parameters of the reopenForumTopic method.
+type ReopenForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+// ReopenForumTopic reopens a closed topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
+func (t *ApiClient) ReopenForumTopic(p *ReopenForumTopicParams) error {
+	return t.ReopenForumTopicContext(context.Background(), p)
+}
+
+// ReopenForumTopicContext is like ReopenForumTopic, but the request is bound to ctx.
+func (t *ApiClient) ReopenForumTopicContext(ctx context.Context, p *ReopenForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "reopenForumTopic", p, &ok)
+}
+
+// DeleteForumTopicParams are the parameters of the deleteForumTopic method.
+type DeleteForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+// DeleteForumTopic deletes a forum topic along with all its messages in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_delete_messages administrator rights.
+func (t *ApiClient) DeleteForumTopic(p *DeleteForumTopicParams) error {
+	return t.DeleteForumTopicContext(context.Background(), p)
+}
+
+// DeleteForumTopicContext is like DeleteForumTopic, but the request is bound to ctx.
+func (t *ApiClient) DeleteForumTopicContext(ctx context.Context, p *DeleteForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteForumTopic", p, &ok)
+}
+
+// UnpinAllForumTopicMessagesParams are the parameters of the unpinAllForumTopicMessages method.
+type UnpinAllForumTopicMessagesParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int64 `json:"message_thread_id"`
+}
+
+// UnpinAllForumTopicMessages clears the list of pinned messages in a forum topic. The bot must be an administrator in the chat for this to work and must have the can_pin_messages administrator right in the supergroup.
+func (t *ApiClient) UnpinAllForumTopicMessages(p *UnpinAllForumTopicMessagesParams) error {
+	return t.UnpinAllForumTopicMessagesContext(context.Background(), p)
+}
+
+// UnpinAllForumTopicMessagesContext is like UnpinAllForumTopicMessages, but the request is bound to ctx.
+func (t *ApiClient) UnpinAllForumTopicMessagesContext(ctx context.Context, p *UnpinAllForumTopicMessagesParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "unpinAllForumTopicMessages", p, &ok)
+}
+
+// EditGeneralForumTopicParams are the parameters of the editGeneralForumTopic method.
+type EditGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+	// New topic name, 1-128 characters
+	Name string `json:"name"`
+}
+
+// Validate checks the parameters against the limits of the editGeneralForumTopic method.
+func (p *EditGeneralForumTopicParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 1, 128)
+	return v.err()
+}
+
+// EditGeneralForumTopic edits the name of the 'General' topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have can_manage_topics administrator rights.
+func (t *ApiClient) EditGeneralForumTopic(p *EditGeneralForumTopicParams) error {
+	return t.EditGeneralForumTopicContext(context.Background(), p)
+}
+
+// EditGeneralForumTopicContext is like EditGeneralForumTopic, but the request is bound to ctx.
+func (t *ApiClient) EditGeneralForumTopicContext(ctx context.Context, p *EditGeneralForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "editGeneralForumTopic", p, &ok)
+}
+
+// CloseGeneralForumTopicParams are the parameters of the closeGeneralForumTopic method.
+type CloseGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// CloseGeneralForumTopic closes an open 'General' topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+func (t *ApiClient) CloseGeneralForumTopic(p *CloseGeneralForumTopicParams) error {
+	return t.CloseGeneralForumTopicContext(context.Background(), p)
+}
+
+// CloseGeneralForumTopicContext is like CloseGeneralForumTopic, but the request is bound to ctx.
+func (t *ApiClient) CloseGeneralForumTopicContext(ctx context.Context, p *CloseGeneralForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "closeGeneralForumTopic", p, &ok)
+}
+
+// ReopenGeneralForumTopicParams are the parameters of the reopenGeneralForumTopic method.
+type ReopenGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// ReopenGeneralForumTopic reopens a closed 'General' topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights. The topic will be automatically unhidden if it was hidden.
+func (t *ApiClient) ReopenGeneralForumTopic(p *ReopenGeneralForumTopicParams) error {
+	return t.ReopenGeneralForumTopicContext(context.Background(), p)
+}
+
+// ReopenGeneralForumTopicContext is like ReopenGeneralForumTopic, but the request is bound to ctx.
+func (t *ApiClient) ReopenGeneralForumTopicContext(ctx context.Context, p *ReopenGeneralForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "reopenGeneralForumTopic", p, &ok)
+}
+
+// HideGeneralForumTopicParams are the parameters of the hideGeneralForumTopic method.
+type HideGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// HideGeneralForumTopic hides the 'General' topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights. The topic will be automatically closed if it was open.
+func (t *ApiClient) HideGeneralForumTopic(p *HideGeneralForumTopicParams) error {
+	return t.HideGeneralForumTopicContext(context.Background(), p)
+}
+
+// HideGeneralForumTopicContext is like HideGeneralForumTopic, but the request is bound to ctx.
+func (t *ApiClient) HideGeneralForumTopicContext(ctx context.Context, p *HideGeneralForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "hideGeneralForumTopic", p, &ok)
+}
+
+// UnhideGeneralForumTopicParams are the parameters of the unhideGeneralForumTopic method.
+type UnhideGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// UnhideGeneralForumTopic unhides the 'General' topic in a forum supergroup chat. The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+func (t *ApiClient) UnhideGeneralForumTopic(p *UnhideGeneralForumTopicParams) error {
+	return t.UnhideGeneralForumTopicContext(context.Background(), p)
+}
+
+// UnhideGeneralForumTopicContext is like UnhideGeneralForumTopic, but the request is bound to ctx.
+func (t *ApiClient) UnhideGeneralForumTopicContext(ctx context.Context, p *UnhideGeneralForumTopicParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "unhideGeneralForumTopic", p, &ok)
+}
+
+// UnpinAllGeneralForumTopicMessagesParams are the parameters of the unpinAllGeneralForumTopicMessages method.
+type UnpinAllGeneralForumTopicMessagesParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId string `json:"chat_id"`
+}
+
+// UnpinAllGeneralForumTopicMessages clears the list of pinned messages in a General forum topic. The bot must be an administrator in the chat for this to work and must have the can_pin_messages administrator right in the supergroup.
+func (t *ApiClient) UnpinAllGeneralForumTopicMessages(p *UnpinAllGeneralForumTopicMessagesParams) error {
+	return t.UnpinAllGeneralForumTopicMessagesContext(context.Background(), p)
+}
+
+// UnpinAllGeneralForumTopicMessagesContext is like UnpinAllGeneralForumTopicMessages, but the request is bound to ctx.
+func (t *ApiClient) UnpinAllGeneralForumTopicMessagesContext(ctx context.Context, p *UnpinAllGeneralForumTopicMessagesParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "unpinAllGeneralForumTopicMessages", p, &ok)
+}
+
+// GetUserChatBoostsParams are the parameters of the getUserChatBoosts method.
+type GetUserChatBoostsParams struct {
+	// Unique identifier for the chat or username of the channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+}
+
+// GetUserChatBoosts returns the list of boosts added to a chat by a user. Requires administrator rights in the chat.
+func (t *ApiClient) GetUserChatBoosts(p *GetUserChatBoostsParams) (*UserChatBoosts, error) {
+	return t.GetUserChatBoostsContext(context.Background(), p)
+}
+
+// GetUserChatBoostsContext is like GetUserChatBoosts, but the request is bound to ctx.
+func (t *ApiClient) GetUserChatBoostsContext(ctx context.Context, p *GetUserChatBoostsParams) (*UserChatBoosts, error) {
+	out := new(UserChatBoosts)
+	if err := t.CallContext(ctx, "POST", "getUserChatBoosts", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBusinessConnectionParams are the parameters of the getBusinessConnection method.
+type GetBusinessConnectionParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionId string `json:"business_connection_id"`
+}
+
+// GetBusinessConnection returns information about the connection of the bot with a business account.
+func (t *ApiClient) GetBusinessConnection(p *GetBusinessConnectionParams) (*BusinessConnection, error) {
+	return t.GetBusinessConnectionContext(context.Background(), p)
+}
+
+// GetBusinessConnectionContext is like GetBusinessConnection, but the request is bound to ctx.
+func (t *ApiClient) GetBusinessConnectionContext(ctx context.Context, p *GetBusinessConnectionParams) (*BusinessConnection, error) {
+	out := new(BusinessConnection)
+	if err := t.CallContext(ctx, "POST", "getBusinessConnection", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetMyNameParams are the parameters of the setMyName method.
+type SetMyNameParams struct {
+	// Optional. New bot name; 0-64 characters. Pass an empty string to remove the dedicated name for the given language.
+	Name string `json:"name,omitempty"`
+	// Optional. A two-letter ISO 639-1 language code. If empty, the name will be shown to all users for whose language there is no dedicated name.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the setMyName method.
+func (p *SetMyNameParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 0, 64)
+	return v.err()
+}
+
+// SetMyName changes the bot's name.
+func (t *ApiClient) SetMyName(p *SetMyNameParams) error {
+	return t.SetMyNameContext(context.Background(), p)
+}
+
+// SetMyNameContext is like SetMyName, but the request is bound to ctx.
+func (t *ApiClient) SetMyNameContext(ctx context.Context, p *SetMyNameParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setMyName", p, &ok)
+}
+
+// GetMyNameParams are the parameters of the getMyName method.
+type GetMyNameParams struct {
+	// Optional. A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyName returns the current bot name for the given user language.
+func (t *ApiClient) GetMyName(p *GetMyNameParams) (*BotName, error) {
+	return t.GetMyNameContext(context.Background(), p)
+}
+
+// GetMyNameContext is like GetMyName, but the request is bound to ctx.
+func (t *ApiClient) GetMyNameContext(ctx context.Context, p *GetMyNameParams) (*BotName, error) {
+	out := new(BotName)
+	if err := t.CallContext(ctx, "POST", "getMyName", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetMyDescriptionParams are the parameters of the setMyDescription method.
+type SetMyDescriptionParams struct {
+	// Optional. New bot description; 0-512 characters. Pass an empty string to remove the dedicated description for the given language.
+	Description string `json:"description,omitempty"`
+	// Optional. A two-letter ISO 639-1 language code. If empty, the description will be applied to all users for whose language there is no dedicated description.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the setMyDescription method.
+func (p *SetMyDescriptionParams) Validate() error {
+	var v validator
+	v.chars("description", p.Description, 0, 512)
+	return v.err()
+}
+
+// SetMyDescription changes the bot's description, which is shown in the chat with the bot if the chat is empty.
+func (t *ApiClient) SetMyDescription(p *SetMyDescriptionParams) error {
+	return t.SetMyDescriptionContext(context.Background(), p)
+}
+
+// SetMyDescriptionContext is like SetMyDescription, but the request is bound to ctx.
+func (t *ApiClient) SetMyDescriptionContext(ctx context.Context, p *SetMyDescriptionParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setMyDescription", p, &ok)
+}
+
+// GetMyDescriptionParams are the parameters of the getMyDescription method.
+type GetMyDescriptionParams struct {
+	// Optional. A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyDescription returns the current bot description for the given user language.
+func (t *ApiClient) GetMyDescription(p *GetMyDescriptionParams) (*BotDescription, error) {
+	return t.GetMyDescriptionContext(context.Background(), p)
+}
+
+// GetMyDescriptionContext is like GetMyDescription, but the request is bound to ctx.
+func (t *ApiClient) GetMyDescriptionContext(ctx context.Context, p *GetMyDescriptionParams) (*BotDescription, error) {
+	out := new(BotDescription)
+	if err := t.CallContext(ctx, "POST", "getMyDescription", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetMyShortDescriptionParams are the parameters of the setMyShortDescription method.
+type SetMyShortDescriptionParams struct {
+	// Optional. New short description for the bot; 0-120 characters. Pass an empty string to remove the dedicated short description for the given language.
+	ShortDescription string `json:"short_description,omitempty"`
+	// Optional. A two-letter ISO 639-1 language code. If empty, the short description will be applied to all users for whose language there is no dedicated short description.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the setMyShortDescription method.
+func (p *SetMyShortDescriptionParams) Validate() error {
+	var v validator
+	v.chars("short_description", p.ShortDescription, 0, 120)
+	return v.err()
+}
+
+// SetMyShortDescription changes the bot's short description, which is shown on the bot's profile page and is sent together with the link when users share the bot.
+func (t *ApiClient) SetMyShortDescription(p *SetMyShortDescriptionParams) error {
+	return t.SetMyShortDescriptionContext(context.Background(), p)
+}
+
+// SetMyShortDescriptionContext is like SetMyShortDescription, but the request is bound to ctx.
+func (t *ApiClient) SetMyShortDescriptionContext(ctx context.Context, p *SetMyShortDescriptionParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setMyShortDescription", p, &ok)
+}
+
+// GetMyShortDescriptionParams are the parameters of the getMyShortDescription method.
+type GetMyShortDescriptionParams struct {
+	// Optional. A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyShortDescription returns the current bot short description for the given user language.
+func (t *ApiClient) GetMyShortDescription(p *GetMyShortDescriptionParams) (*BotShortDescription, error) {
+	return t.GetMyShortDescriptionContext(context.Background(), p)
+}
+
+// GetMyShortDescriptionContext is like GetMyShortDescription, but the request is bound to ctx.
+func (t *ApiClient) GetMyShortDescriptionContext(ctx context.Context, p *GetMyShortDescriptionParams) (*BotShortDescription, error) {
+	out := new(BotShortDescription)
+	if err := t.CallContext(ctx, "POST", "getMyShortDescription", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetChatMenuButtonParams are the parameters of the setChatMenuButton method.
+type SetChatMenuButtonParams struct {
+	// Optional. Unique identifier for the target private chat. If not specified, default bot's menu button will be changed
+	ChatId *int64 `json:"chat_id,omitempty"`
+	// Optional. A JSON-serialized object for the bot's new menu button. Defaults to MenuButtonDefault
+	MenuButton MenuButton `json:"menu_button,omitempty"`
+}
+
+// SetChatMenuButton changes the bot's menu button in a private chat, or the default menu button.
+func (t *ApiClient) SetChatMenuButton(p *SetChatMenuButtonParams) error {
+	return t.SetChatMenuButtonContext(context.Background(), p)
+}
+
+// SetChatMenuButtonContext is like SetChatMenuButton, but the request is bound to ctx.
+func (t *ApiClient) SetChatMenuButtonContext(ctx context.Context, p *SetChatMenuButtonParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setChatMenuButton", p, &ok)
+}
+
+// GetChatMenuButtonParams are the parameters of the getChatMenuButton method.
+type GetChatMenuButtonParams struct {
+	// Optional. Unique identifier for the target private chat. If not specified, default bot's menu button will be returned
+	ChatId *int64 `json:"chat_id,omitempty"`
+}
+
+// GetChatMenuButton returns the current value of the bot's menu button in a private chat, or the default menu button.
+func (t *ApiClient) GetChatMenuButton(p *GetChatMenuButtonParams) (MenuButton, error) {
+	return t.GetChatMenuButtonContext(context.Background(), p)
+}
+
+// GetChatMenuButtonContext is like GetChatMenuButton, but the request is bound to ctx.
+func (t *ApiClient) GetChatMenuButtonContext(ctx context.Context, p *GetChatMenuButtonParams) (MenuButton, error) {
+	var result json.RawMessage
+	if err := t.CallContext(ctx, "POST", "getChatMenuButton", p, &result); err != nil {
+		return nil, err
+	}
+	return unmarshalMenuButton(result)
+}
+
+// SetMyDefaultAdministratorRightsParams are the parameters of the setMyDefaultAdministratorRights method.
+type SetMyDefaultAdministratorRightsParams struct {
+	// Optional. A JSON-serialized object describing new default administrator rights. If not specified, the default administrator rights will be cleared.
+	Rights *ChatAdministratorRights `json:"rights,omitempty"`
+	// Optional. Pass True to change the default administrator rights of the bot in channels. Otherwise, the default administrator rights of the bot for groups and supergroups will be changed.
+	ForChannels *bool `json:"for_channels,omitempty"`
+}
+
+// SetMyDefaultAdministratorRights changes the default administrator rights requested by the bot when it's added as an administrator to groups or channels. These rights will be suggested to users, but they are free to modify the list before adding the bot.
+func (t *ApiClient) SetMyDefaultAdministratorRights(p *SetMyDefaultAdministratorRightsParams) error {
+	return t.SetMyDefaultAdministratorRightsContext(context.Background(), p)
+}
+
+// SetMyDefaultAdministratorRightsContext is like SetMyDefaultAdministratorRights, but the request is bound to ctx.
+func (t *ApiClient) SetMyDefaultAdministratorRightsContext(ctx context.Context, p *SetMyDefaultAdministratorRightsParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setMyDefaultAdministratorRights", p, &ok)
+}
+
+// GetMyDefaultAdministratorRightsParams are the parameters of the getMyDefaultAdministratorRights method.
+type GetMyDefaultAdministratorRightsParams struct {
+	// Optional. Pass True to get default administrator rights of the bot in channels. Otherwise, default administrator rights of the bot for groups and supergroups will be returned.
+	ForChannels *bool `json:"for_channels,omitempty"`
+}
+
+// GetMyDefaultAdministratorRights returns the current default administrator rights of the bot.
+func (t *ApiClient) GetMyDefaultAdministratorRights(p *GetMyDefaultAdministratorRightsParams) (*ChatAdministratorRights, error) {
+	return t.GetMyDefaultAdministratorRightsContext(context.Background(), p)
+}
+
+// GetMyDefaultAdministratorRightsContext is like GetMyDefaultAdministratorRights, but the request is bound to ctx.
+func (t *ApiClient) GetMyDefaultAdministratorRightsContext(ctx context.Context, p *GetMyDefaultAdministratorRightsParams) (*ChatAdministratorRights, error) {
+	out := new(ChatAdministratorRights)
+	if err := t.CallContext(ctx, "POST", "getMyDefaultAdministratorRights", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EditMessageLiveLocationParams are the parameters of the editMessageLiveLocation method.
+type EditMessageLiveLocationParams struct {
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+	// Latitude of new location
+	Latitude float64 `json:"latitude"`
+	// Longitude of new location
+	Longitude float64 `json:"longitude"`
+	// Optional. New period in seconds during which the location can be updated, starting from the message send date. If 0x7FFFFFFF is specified, then the location can be updated forever. Otherwise, the new value must not exceed the current live_period by more than a day, and the live location expiration date must remain within the next 90 days. If not specified, then live_period remains unchanged
+	LivePeriod *int64 `json:"live_period,omitempty"`
+	// Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy *float64 `json:"horizontal_accuracy,omitempty"`
+	// Optional. Direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading *int64 `json:"heading,omitempty"`
+	// Optional. The maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius *int64 `json:"proximity_alert_radius,omitempty"`
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the editMessageLiveLocation method.
+func (p *EditMessageLiveLocationParams) Validate() error {
+	var v validator
+	if p.HorizontalAccuracy != nil {
+		v.floatRange("horizontal_accuracy", *p.HorizontalAccuracy, 0, 1500)
+	}
+	if p.Heading != nil {
+		v.intRange("heading", *p.Heading, 1, 360)
+	}
+	if p.ProximityAlertRadius != nil {
+		v.intRange("proximity_alert_radius", *p.ProximityAlertRadius, 1, 100000)
+	}
+	return v.err()
+}
+
+// EditMessageLiveLocation edits live location messages. A location can be edited until its live_period expires or editing is explicitly disabled by a call to stopMessageLiveLocation. The edited Message is returned, or nil for inline messages.
+func (t *ApiClient) EditMessageLiveLocation(p *EditMessageLiveLocationParams) (*Message, error) {
+	return t.EditMessageLiveLocationContext(context.Background(), p)
+}
+
+// EditMessageLiveLocationContext is like EditMessageLiveLocation, but the request is bound to ctx.
+func (t *ApiClient) EditMessageLiveLocationContext(ctx context.Context, p *EditMessageLiveLocationParams) (*Message, error) {
+	var result json.RawMessage
+	if err := t.CallContext(ctx, "POST", "editMessageLiveLocation", p, &result); err != nil {
+		return nil, err
+	}
+	return editResult(result)
+}
+
+// StopMessageLiveLocationParams are the parameters of the stopMessageLiveLocation method.
+type StopMessageLiveLocationParams struct {
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the message with live location to stop
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopMessageLiveLocation stops updating a live location message before live_period expires. The edited Message is returned, or nil for inline messages.
+func (t *ApiClient) StopMessageLiveLocation(p *StopMessageLiveLocationParams) (*Message, error) {
+	return t.StopMessageLiveLocationContext(context.Background(), p)
+}
+
+// StopMessageLiveLocationContext is like StopMessageLiveLocation, but the request is bound to ctx.
+func (t *ApiClient) StopMessageLiveLocationContext(ctx context.Context, p *StopMessageLiveLocationParams) (*Message, error) {
+	var result json.RawMessage
+	if err := t.CallContext(ctx, "POST", "stopMessageLiveLocation", p, &result); err != nil {
+		return nil, err
+	}
+	return editResult(result)
+}
+
+// StopPollParams are the parameters of the stopPoll method.
+type StopPollParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Identifier of the original message with the poll
+	MessageId int64 `json:"message_id"`
+	// Optional. A JSON-serialized object for a new message inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopPoll stops a poll which was sent by the bot. The stopped Poll is returned.
+func (t *ApiClient) StopPoll(p *StopPollParams) (*Poll, error) {
+	return t.StopPollContext(context.Background(), p)
+}
+
+// StopPollContext is like StopPoll, but the request is bound to ctx.
+func (t *ApiClient) StopPollContext(ctx context.Context, p *StopPollParams) (*Poll, error) {
+	out := new(Poll)
+	if err := t.CallContext(ctx, "POST", "stopPoll", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetStickerSetParams are the parameters of the getStickerSet method.
+type GetStickerSetParams struct {
+	// Name of the sticker set
+	Name string `json:"name"`
+}
+
+// GetStickerSet returns a sticker set.
+func (t *ApiClient) GetStickerSet(p *GetStickerSetParams) (*StickerSet, error) {
+	return t.GetStickerSetContext(context.Background(), p)
+}
+
+// GetStickerSetContext is like GetStickerSet, but the request is bound to ctx.
+func (t *ApiClient) GetStickerSetContext(ctx context.Context, p *GetStickerSetParams) (*StickerSet, error) {
+	out := new(StickerSet)
+	if err := t.CallContext(ctx, "POST", "getStickerSet", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetCustomEmojiStickersParams are the parameters of the getCustomEmojiStickers method.
+type GetCustomEmojiStickersParams struct {
+	// A JSON-serialized list of custom emoji identifiers. At most 200 custom emoji identifiers can be specified.
+	CustomEmojiIds []string `json:"custom_emoji_ids"`
+}
+
+// GetCustomEmojiStickers returns information about custom emoji stickers by their identifiers.
+func (t *ApiClient) GetCustomEmojiStickers(p *GetCustomEmojiStickersParams) ([]*Sticker, error) {
+	return t.GetCustomEmojiStickersContext(context.Background(), p)
+}
+
+// GetCustomEmojiStickersContext is like GetCustomEmojiStickers, but the request is bound to ctx.
+func (t *ApiClient) GetCustomEmojiStickersContext(ctx context.Context, p *GetCustomEmojiStickersParams) ([]*Sticker, error) {
+	var out []*Sticker
+	if err := t.CallContext(ctx, "POST", "getCustomEmojiStickers", p, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateNewStickerSetParams are the parameters of the createNewStickerSet method.
+type CreateNewStickerSetParams struct {
+	// User identifier of created sticker set owner
+	UserId int64 `json:"user_id"`
+	// Short name of sticker set, to be used in t.me/addstickers/ URLs (e.g., animals). Can contain only English letters, digits and underscores. Must begin with a letter, can't contain consecutive underscores and must end in "_by_<bot_username>". <bot_username> is case insensitive. 1-64 characters.
+	Name string `json:"name"`
+	// Sticker set title, 1-64 characters
+	Title string `json:"title"`
+	// A JSON-serialized list of 1-50 initial stickers to be added to the sticker set
+	Stickers []*InputSticker `json:"stickers"`
+	// Optional. Type of stickers in the set, pass “regular”, “mask”, or “custom_emoji”. By default, a regular sticker set is created.
+	StickerType string `json:"sticker_type,omitempty"`
+	// Optional. Pass True if stickers in the sticker set must be repainted to the color of text when used in messages, the accent color if used as emoji status, white on chat photos, or another appropriate color based on context; for custom emoji sticker sets only
+	NeedsRepainting *bool `json:"needs_repainting,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the createNewStickerSet method.
+func (p *CreateNewStickerSetParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 1, 64)
+	v.chars("title", p.Title, 1, 64)
+	return v.err()
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user. The bot will be able to edit the sticker set thus created.
+func (t *ApiClient) CreateNewStickerSet(p *CreateNewStickerSetParams) error {
+	return t.CreateNewStickerSetContext(context.Background(), p)
+}
+
+// CreateNewStickerSetContext is like CreateNewStickerSet, but the request is bound to ctx.
+func (t *ApiClient) CreateNewStickerSetContext(ctx context.Context, p *CreateNewStickerSetParams) error {
+	var ok bool
+	return t.callUpload(ctx, "createNewStickerSet", p, &ok)
+}
+
+// AddStickerToSetParams are the parameters of the addStickerToSet method.
+type AddStickerToSetParams struct {
+	// User identifier of sticker set owner
+	UserId int64 `json:"user_id"`
+	// Sticker set name
+	Name string `json:"name"`
+	// A JSON-serialized object with information about the added sticker. If exactly the same sticker had already been added to the set, then the set isn't changed.
+	Sticker *InputSticker `json:"sticker"`
+}
+
+// AddStickerToSet adds a new sticker to a set created by the bot. Emoji sticker sets can have up to 200 stickers. Other sticker sets can have up to 120 stickers.
+func (t *ApiClient) AddStickerToSet(p *AddStickerToSetParams) error {
+	return t.AddStickerToSetContext(context.Background(), p)
+}
+
+// AddStickerToSetContext is like AddStickerToSet, but the request is bound to ctx.
+func (t *ApiClient) AddStickerToSetContext(ctx context.Context, p *AddStickerToSetParams) error {
+	var ok bool
+	return t.callUpload(ctx, "addStickerToSet", p, &ok)
+}
+
+// SetStickerPositionInSetParams are the parameters of the setStickerPositionInSet method.
+type SetStickerPositionInSetParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// New sticker position in the set, zero-based
+	Position int64 `json:"position"`
+}
+
+// SetStickerPositionInSet moves a sticker in a set created by the bot to a specific position.
+func (t *ApiClient) SetStickerPositionInSet(p *SetStickerPositionInSetParams) error {
+	return t.SetStickerPositionInSetContext(context.Background(), p)
+}
+
+// SetStickerPositionInSetContext is like SetStickerPositionInSet, but the request is bound to ctx.
+func (t *ApiClient) SetStickerPositionInSetContext(ctx context.Context, p *SetStickerPositionInSetParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setStickerPositionInSet", p, &ok)
+}
+
+// DeleteStickerFromSetParams are the parameters of the deleteStickerFromSet method.
+type DeleteStickerFromSetParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+}
+
+// DeleteStickerFromSet deletes a sticker from a set created by the bot.
+func (t *ApiClient) DeleteStickerFromSet(p *DeleteStickerFromSetParams) error {
+	return t.DeleteStickerFromSetContext(context.Background(), p)
+}
+
+// DeleteStickerFromSetContext is like DeleteStickerFromSet, but the request is bound to ctx.
+func (t *ApiClient) DeleteStickerFromSetContext(ctx context.Context, p *DeleteStickerFromSetParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteStickerFromSet", p, &ok)
+}
+
+// ReplaceStickerInSetParams are the parameters of the replaceStickerInSet method.
+type ReplaceStickerInSetParams struct {
+	// User identifier of the sticker set owner
+	UserId int64 `json:"user_id"`
+	// Sticker set name
+	Name string `json:"name"`
+	// File identifier of the replaced sticker
+	OldSticker string `json:"old_sticker"`
+	// A JSON-serialized object with information about the added sticker. If exactly the same sticker had already been added to the set, then the set remains unchanged.
+	Sticker *InputSticker `json:"sticker"`
+}
+
+// ReplaceStickerInSet replaces an existing sticker in a sticker set with a new one. The method is equivalent to calling deleteStickerFromSet, then addStickerToSet, then setStickerPositionInSet.
+func (t *ApiClient) ReplaceStickerInSet(p *ReplaceStickerInSetParams) error {
+	return t.ReplaceStickerInSetContext(context.Background(), p)
+}
+
+// ReplaceStickerInSetContext is like ReplaceStickerInSet, but the request is bound to ctx.
+func (t *ApiClient) ReplaceStickerInSetContext(ctx context.Context, p *ReplaceStickerInSetParams) error {
+	var ok bool
+	return t.callUpload(ctx, "replaceStickerInSet", p, &ok)
+}
+
+// SetStickerEmojiListParams are the parameters of the setStickerEmojiList method.
+type SetStickerEmojiListParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// A JSON-serialized list of 1-20 emoji associated with the sticker
+	EmojiList []string `json:"emoji_list"`
+}
+
+// SetStickerEmojiList changes the list of emoji assigned to a regular or custom emoji sticker. The sticker must belong to a sticker set created by the bot.
+func (t *ApiClient) SetStickerEmojiList(p *SetStickerEmojiListParams) error {
+	return t.SetStickerEmojiListContext(context.Background(), p)
+}
+
+// SetStickerEmojiListContext is like SetStickerEmojiList, but the request is bound to ctx.
+func (t *ApiClient) SetStickerEmojiListContext(ctx context.Context, p *SetStickerEmojiListParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setStickerEmojiList", p, &ok)
+}
+
+// SetStickerKeywordsParams are the parameters of the setStickerKeywords method.
+type SetStickerKeywordsParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// Optional. A JSON-serialized list of 0-20 search keywords for the sticker with total length of up to 64 characters
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// SetStickerKeywords changes search keywords assigned to a regular or custom emoji sticker. The sticker must belong to a sticker set created by the bot.
+func (t *ApiClient) SetStickerKeywords(p *SetStickerKeywordsParams) error {
+	return t.SetStickerKeywordsContext(context.Background(), p)
+}
+
+// SetStickerKeywordsContext is like SetStickerKeywords, but the request is bound to ctx.
+func (t *ApiClient) SetStickerKeywordsContext(ctx context.Context, p *SetStickerKeywordsParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setStickerKeywords", p, &ok)
+}
+
+// SetStickerMaskPositionParams are the parameters of the setStickerMaskPosition method.
+type SetStickerMaskPositionParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// Optional. A JSON-serialized object with the position where the mask should be placed on faces. Omit the parameter to remove the mask position.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+// SetStickerMaskPosition changes the mask position of a mask sticker. The sticker must belong to a sticker set that was created by the bot.
+func (t *ApiClient) SetStickerMaskPosition(p *SetStickerMaskPositionParams) error {
+	return t.SetStickerMaskPositionContext(context.Background(), p)
+}
+
+// SetStickerMaskPositionContext is like SetStickerMaskPosition, but the request is bound to ctx.
+func (t *ApiClient) SetStickerMaskPositionContext(ctx context.Context, p *SetStickerMaskPositionParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setStickerMaskPosition", p, &ok)
+}
+
+// SetStickerSetTitleParams are the parameters of the setStickerSetTitle method.
+type SetStickerSetTitleParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// Sticker set title, 1-64 characters
+	Title string `json:"title"`
+}
+
+// Validate checks the parameters against the limits of the setStickerSetTitle method.
+func (p *SetStickerSetTitleParams) Validate() error {
+	var v validator
+	v.chars("title", p.Title, 1, 64)
+	return v.err()
+}
+
+// SetStickerSetTitle sets the title of a created sticker set.
+func (t *ApiClient) SetStickerSetTitle(p *SetStickerSetTitleParams) error {
+	return t.SetStickerSetTitleContext(context.Background(), p)
+}
+
+// SetStickerSetTitleContext is like SetStickerSetTitle, but the request is bound to ctx.
+func (t *ApiClient) SetStickerSetTitleContext(ctx context.Context, p *SetStickerSetTitleParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setStickerSetTitle", p, &ok)
+}
+
+// SetStickerSetThumbnailParams are the parameters of the setStickerSetThumbnail method.
+type SetStickerSetThumbnailParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// User identifier of the sticker set owner
+	UserId int64 `json:"user_id"`
+	// Optional. A .WEBP or .PNG image with the thumbnail, must be up to 128 kilobytes in size and have a width and height of exactly 100px, or a .TGS animation with a thumbnail up to 32 kilobytes in size, or a WEBM video with the thumbnail up to 32 kilobytes in size. Animated and video sticker set thumbnails can't be uploaded via HTTP URL. If omitted, then the thumbnail is dropped and the first sticker is used as the thumbnail.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Format of the thumbnail, must be one of “static” for a .WEBP or .PNG image, “animated” for a .TGS animation, or “video” for a WEBM video
+	Format string `json:"format"`
+}
+
+// SetStickerSetThumbnail sets the thumbnail of a regular or mask sticker set. The format of the thumbnail file must match the format of the stickers in the set.
+func (t *ApiClient) SetStickerSetThumbnail(p *SetStickerSetThumbnailParams) error {
+	return t.SetStickerSetThumbnailContext(context.Background(), p)
+}
+
+// SetStickerSetThumbnailContext is like SetStickerSetThumbnail, but the request is bound to ctx.
+func (t *ApiClient) SetStickerSetThumbnailContext(ctx context.Context, p *SetStickerSetThumbnailParams) error {
+	var ok bool
+	return t.callUpload(ctx, "setStickerSetThumbnail", p, &ok)
+}
+
+// SetCustomEmojiStickerSetThumbnailParams are the parameters of the setCustomEmojiStickerSetThumbnail method.
+type SetCustomEmojiStickerSetThumbnailParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// Optional. Custom emoji identifier of a sticker from the sticker set; pass an empty string to drop the thumbnail and use the first sticker as the thumbnail.
+	CustomEmojiId string `json:"custom_emoji_id,omitempty"`
+}
+
+// SetCustomEmojiStickerSetThumbnail sets the thumbnail of a custom emoji sticker set.
+func (t *ApiClient) SetCustomEmojiStickerSetThumbnail(p *SetCustomEmojiStickerSetThumbnailParams) error {
+	return t.SetCustomEmojiStickerSetThumbnailContext(context.Background(), p)
+}
+
+// SetCustomEmojiStickerSetThumbnailContext is like SetCustomEmojiStickerSetThumbnail, but the request is bound to ctx.
+func (t *ApiClient) SetCustomEmojiStickerSetThumbnailContext(ctx context.Context, p *SetCustomEmojiStickerSetThumbnailParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setCustomEmojiStickerSetThumbnail", p, &ok)
+}
+
+// DeleteStickerSetParams are the parameters of the deleteStickerSet method.
+type DeleteStickerSetParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+}
+
+// DeleteStickerSet deletes a sticker set that was created by the bot.
+func (t *ApiClient) DeleteStickerSet(p *DeleteStickerSetParams) error {
+	return t.DeleteStickerSetContext(context.Background(), p)
+}
+
+// DeleteStickerSetContext is like DeleteStickerSet, but the request is bound to ctx.
+func (t *ApiClient) DeleteStickerSetContext(ctx context.Context, p *DeleteStickerSetParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteStickerSet", p, &ok)
+}
+
+// AnswerWebAppQueryParams are the parameters of the answerWebAppQuery method.
+type AnswerWebAppQueryParams struct {
+	// Unique identifier for the query to be answered
+	WebAppQueryId string `json:"web_app_query_id"`
+	// A JSON-serialized object describing the message to be sent
+	Result InlineQueryResult `json:"result"`
+}
+
+// AnswerWebAppQuery sets the result of an interaction with a Web App and sends a corresponding message on behalf of the user to the chat from which the query originated.
+func (t *ApiClient) AnswerWebAppQuery(p *AnswerWebAppQueryParams) (*SentWebAppMessage, error) {
+	return t.AnswerWebAppQueryContext(context.Background(), p)
+}
+
+// AnswerWebAppQueryContext is like AnswerWebAppQuery, but the request is bound to ctx.
+func (t *ApiClient) AnswerWebAppQueryContext(ctx context.Context, p *AnswerWebAppQueryParams) (*SentWebAppMessage, error) {
+	out := new(SentWebAppMessage)
+	if err := t.CallContext(ctx, "POST", "answerWebAppQuery", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendInvoiceParams are the parameters of the sendInvoice method.
+type SendInvoiceParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Product name, 1-32 characters
+	Title string `json:"title"`
+	// Product description, 1-255 characters
+	Description string `json:"description"`
+	// Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use it for your internal processes.
+	Payload string `json:"payload"`
+	// Optional. Payment provider token, obtained via @BotFather. Pass an empty string for payments in Telegram Stars.
+	ProviderToken string `json:"provider_token,omitempty"`
+	// Three-letter ISO 4217 currency code, see more on currencies. Pass “XTR” for payments in Telegram Stars.
+	Currency string `json:"currency"`
+	// Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.). Must contain exactly one item for payments in Telegram Stars.
+	Prices []*LabeledPrice `json:"prices"`
+	// Optional. The maximum accepted amount for tips in the smallest units of the currency (integer, not float/double). Defaults to 0. Not supported for payments in Telegram Stars.
+	MaxTipAmount *int64 `json:"max_tip_amount,omitempty"`
+	// Optional. A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer, not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+	// Optional. Unique deep-linking parameter. If left empty, forwarded copies of the sent message will have a Pay button, allowing multiple users to pay directly from the forwarded message, using the same invoice. If non-empty, forwarded copies of the sent message will have a URL button with a deep link to the bot (instead of a Pay button), with the value used as the start parameter
+	StartParameter string `json:"start_parameter,omitempty"`
+	// Optional. JSON-serialized data about the invoice, which will be shared with the payment provider. A detailed description of required fields should be provided by the payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+	// Optional. URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a service. People like it better when they see what they are paying for.
+	PhotoUrl string `json:"photo_url,omitempty"`
+	// Optional. Photo size in bytes
+	PhotoSize *int64 `json:"photo_size,omitempty"`
+	// Optional. Photo width
+	PhotoWidth *int64 `json:"photo_width,omitempty"`
+	// Optional. Photo height
+	PhotoHeight *int64 `json:"photo_height,omitempty"`
+	// Optional. Pass True if you require the user's full name to complete the order. Ignored for payments in Telegram Stars.
+	NeedName *bool `json:"need_name,omitempty"`
+	// Optional. Pass True if you require the user's phone number to complete the order. Ignored for payments in Telegram Stars.
+	NeedPhoneNumber *bool `json:"need_phone_number,omitempty"`
+	// Optional. Pass True if you require the user's email address to complete the order. Ignored for payments in Telegram Stars.
+	NeedEmail *bool `json:"need_email,omitempty"`
+	// Optional. Pass True if you require the user's shipping address to complete the order. Ignored for payments in Telegram Stars.
+	NeedShippingAddress *bool `json:"need_shipping_address,omitempty"`
+	// Optional. Pass True if the user's phone number should be sent to the provider. Ignored for payments in Telegram Stars.
+	SendPhoneNumberToProvider *bool `json:"send_phone_number_to_provider,omitempty"`
+	// Optional. Pass True if the user's email address should be sent to the provider. Ignored for payments in Telegram Stars.
+	SendEmailToProvider *bool `json:"send_email_to_provider,omitempty"`
+	// Optional. Pass True if the final price depends on the shipping method. Ignored for payments in Telegram Stars.
+	IsFlexible *bool `json:"is_flexible,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. A JSON-serialized object for an inline keyboard. If empty, one 'Pay total price' button will be shown. If not empty, the first button must be a Pay button.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the sendInvoice method.
+func (p *SendInvoiceParams) Validate() error {
+	var v validator
+	v.chars("title", p.Title, 1, 32)
+	v.chars("description", p.Description, 1, 255)
+	v.bytes("payload", p.Payload, 1, 128)
+	return v.err()
+}
+
+// SendInvoice sends invoices.
+func (t *ApiClient) SendInvoice(p *SendInvoiceParams) (*Message, error) {
+	return t.SendInvoiceContext(context.Background(), p)
+}
+
+// SendInvoiceContext is like SendInvoice, but the request is bound to ctx.
+func (t *ApiClient) SendInvoiceContext(ctx context.Context, p *SendInvoiceParams) (*Message, error) {
+	out := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendInvoice", p, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateInvoiceLinkParams are the parameters of the createInvoiceLink method.
+type CreateInvoiceLinkParams struct {
+	// Product name, 1-32 characters
+	Title string `json:"title"`
+	// Product description, 1-255 characters
+	Description string `json:"description"`
+	// Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use it for your internal processes.
+	Payload string `json:"payload"`
+	// Optional. Payment provider token, obtained via @BotFather. Pass an empty string for payments in Telegram Stars.
+	ProviderToken string `json:"provider_token,omitempty"`
+	// Three-letter ISO 4217 currency code, see more on currencies. Pass “XTR” for payments in Telegram Stars.
+	Currency string `json:"currency"`
+	// Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.). Must contain exactly one item for payments in Telegram Stars.
+	Prices []*LabeledPrice `json:"prices"`
+	// Optional. The maximum accepted amount for tips in the smallest units of the currency (integer, not float/double). Defaults to 0. Not supported for payments in Telegram Stars.
+	MaxTipAmount *int64 `json:"max_tip_amount,omitempty"`
+	// Optional. A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer, not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+	// Optional. JSON-serialized data about the invoice, which will be shared with the payment provider. A detailed description of required fields should be provided by the payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+	// Optional. URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a service.
+	PhotoUrl string `json:"photo_url,omitempty"`
+	// Optional. Photo size in bytes
+	PhotoSize *int64 `json:"photo_size,omitempty"`
+	// Optional. Photo width
+	PhotoWidth *int64 `json:"photo_width,omitempty"`
+	// Optional. Photo height
+	PhotoHeight *int64 `json:"photo_height,omitempty"`
+	// Optional. Pass True if you require the user's full name to complete the order. Ignored for payments in Telegram Stars.
+	NeedName *bool `json:"need_name,omitempty"`
+	// Optional. Pass True if you require the user's phone number to complete the order. Ignored for payments in Telegram Stars.
+	NeedPhoneNumber *bool `json:"need_phone_number,omitempty"`
+	// Optional. Pass True if you require the user's email address to complete the order. Ignored for payments in Telegram Stars.
+	NeedEmail *bool `json:"need_email,omitempty"`
+	// Optional. Pass True if you require the user's shipping address to complete the order. Ignored for payments in Telegram Stars.
+	NeedShippingAddress *bool `json:"need_shipping_address,omitempty"`
+	// Optional. Pass True if the user's phone number should be sent to the provider. Ignored for payments in Telegram Stars.
+	SendPhoneNumberToProvider *bool `json:"send_phone_number_to_provider,omitempty"`
+	// Optional. Pass True if the user's email address should be sent to the provider. Ignored for payments in Telegram Stars.
+	SendEmailToProvider *bool `json:"send_email_to_provider,omitempty"`
+	// Optional. Pass True if the final price depends on the shipping method. Ignored for payments in Telegram Stars.
+	IsFlexible *bool `json:"is_flexible,omitempty"`
+}
+
+// Validate checks the parameters against the limits of the createInvoiceLink method.
+func (p *CreateInvoiceLinkParams) Validate() error {
+	var v validator
+	v.chars("title", p.Title, 1, 32)
+	v.chars("description", p.Description, 1, 255)
+	v.bytes("payload", p.Payload, 1, 128)
+	return v.err()
+}
+
+// CreateInvoiceLink creates a link for an invoice.
+func (t *ApiClient) CreateInvoiceLink(p *CreateInvoiceLinkParams) (string, error) {
+	return t.CreateInvoiceLinkContext(context.Background(), p)
+}
+
+// CreateInvoiceLinkContext is like CreateInvoiceLink, but the request is bound to ctx.
+func (t *ApiClient) CreateInvoiceLinkContext(ctx context.Context, p *CreateInvoiceLinkParams) (string, error) {
+	var out string
+	if err := t.CallContext(ctx, "POST", "createInvoiceLink", p, &out); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// AnswerShippingQueryParams are the parameters of the answerShippingQuery method.
+type AnswerShippingQueryParams struct {
+	// Unique identifier for the query to be answered
+	ShippingQueryId string `json:"shipping_query_id"`
+	// Pass True if delivery to the specified address is possible and False if there are any problems (for example, if delivery to the specified address is not possible)
+	Ok bool `json:"ok"`
+	// Optional. Required if ok is True. A JSON-serialized array of available shipping options.
+	ShippingOptions []*ShippingOption `json:"shipping_options,omitempty"`
+	// Optional. Required if ok is False. Error message in human readable form that explains why it is impossible to complete the order (e.g. "Sorry, delivery to your desired address is unavailable'). Telegram will display this message to the user.
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// AnswerShippingQuery replies to shipping queries, sent when the invoice requested a shipping address and the parameter is_flexible was specified.
+func (t *ApiClient) AnswerShippingQuery(p *AnswerShippingQueryParams) error {
+	return t.AnswerShippingQueryContext(context.Background(), p)
+}
+
+// AnswerShippingQueryContext is like AnswerShippingQuery, but the request is bound to ctx.
+func (t *ApiClient) AnswerShippingQueryContext(ctx context.Context, p *AnswerShippingQueryParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "answerShippingQuery", p, &ok)
+}
+
+// AnswerPreCheckoutQueryParams are the parameters of the answerPreCheckoutQuery method.
+type AnswerPreCheckoutQueryParams struct {
+	// Unique identifier for the query to be answered
+	PreCheckoutQueryId string `json:"pre_checkout_query_id"`
+	// Specify True if everything is alright (goods are available, etc.) and the bot is ready to proceed with the order. Use False if there are any problems.
+	Ok bool `json:"ok"`
+	// Optional. Required if ok is False. Error message in human readable form that explains the reason for failure to proceed with the checkout (e.g. "Sorry, somebody just bought the last of our amazing black T-shirts while you were busy filling out your payment details. Please choose a different color or garment!"). Telegram will display this message to the user.
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// AnswerPreCheckoutQuery responds to pre-checkout queries, sent as an Update with the field pre_checkout_query. Bots must reply within 10 seconds after the pre-checkout query was sent.
+func (t *ApiClient) AnswerPreCheckoutQuery(p *AnswerPreCheckoutQueryParams) error {
+	return t.AnswerPreCheckoutQueryContext(context.Background(), p)
+}
+
+// AnswerPreCheckoutQueryContext is like AnswerPreCheckoutQuery, but the request is bound to ctx.
+func (t *ApiClient) AnswerPreCheckoutQueryContext(ctx context.Context, p *AnswerPreCheckoutQueryParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "answerPreCheckoutQuery", p, &ok)
+}
+
+// RefundStarPaymentParams are the parameters of the refundStarPayment method.
+type RefundStarPaymentParams struct {
+	// Identifier of the user whose payment will be refunded
+	UserId int64 `json:"user_id"`
+	// Telegram payment identifier
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"`
+}
+
+// RefundStarPayment refunds a successful payment in Telegram Stars.
+func (t *ApiClient) RefundStarPayment(p *RefundStarPaymentParams) error {
+	return t.RefundStarPaymentContext(context.Background(), p)
+}
+
+// RefundStarPaymentContext is like RefundStarPayment, but the request is bound to ctx.
+func (t *ApiClient) RefundStarPaymentContext(ctx context.Context, p *RefundStarPaymentParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "refundStarPayment", p, &ok)
+}
+
+// SetPassportDataErrorsParams are the parameters of the setPassportDataErrors method.
+type SetPassportDataErrorsParams struct {
+	// User identifier
+	UserId int64 `json:"user_id"`
+	// A JSON-serialized array describing the errors
+	Errors []PassportElementError `json:"errors"`
+}
+
+// SetPassportDataErrors informs a user that some of the Telegram Passport elements they provided contains errors. The user will not be able to re-submit their Passport to you until the errors are fixed.
+func (t *ApiClient) SetPassportDataErrors(p *SetPassportDataErrorsParams) error {
+	return t.SetPassportDataErrorsContext(context.Background(), p)
+}
+
+// SetPassportDataErrorsContext is like SetPassportDataErrors, but the request is bound to ctx.
+func (t *ApiClient) SetPassportDataErrorsContext(ctx context.Context, p *SetPassportDataErrorsParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "setPassportDataErrors", p, &ok)
+}
+
+// Validate checks the parameters against the limits of the answerCallbackQuery method.
+func (p *AnswerCallbackQueryParams) Validate() error {
+	var v validator
+	v.chars("text", p.Text, 0, 200)
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the copyMessage method.
+func (p *CopyMessageParams) Validate() error {
+	var v validator
+	if p.Caption != nil {
+		v.formatted("caption", *p.Caption, 0, 1024, p.ParseMode != "")
+	}
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the createChatInviteLink method.
+func (p *CreateChatInviteLinkParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 0, 32)
+	if p.MemberLimit != nil {
+		v.intRange("member_limit", *p.MemberLimit, 1, 99999)
+	}
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the editChatInviteLink method.
+func (p *EditChatInviteLinkParams) Validate() error {
+	var v validator
+	v.chars("name", p.Name, 0, 32)
+	if p.MemberLimit != nil {
+		v.intRange("member_limit", *p.MemberLimit, 1, 99999)
+	}
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the editMessageCaption method.
+func (p *EditMessageCaptionParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the editMessageText method.
+func (p *EditMessageTextParams) Validate() error {
+	var v validator
+	v.formatted("text", p.Text, 1, 4096, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendAnimation method.
+func (p *SendAnimationParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendAudio method.
+func (p *SendAudioParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendDocument method.
+func (p *SendDocumentParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendMessage method.
+func (p *SendMessageParams) Validate() error {
+	var v validator
+	v.formatted("text", p.Text, 1, 4096, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendPhoto method.
+func (p *SendPhotoParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendVideo method.
+func (p *SendVideoParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the sendVoice method.
+func (p *SendVoiceParams) Validate() error {
+	var v validator
+	v.formatted("caption", p.Caption, 0, 1024, p.ParseMode != "")
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the setChatDescription method.
+func (p *setChatDescriptionParams) Validate() error {
+	var v validator
+	v.chars("description", p.Description, 0, 255)
+	return v.err()
+}
+
+// Validate checks the parameters against the limits of the setChatTitle method.
+func (p *setChatTitleParams) Validate() error {
+	var v validator
+	v.chars("title", p.Title, 1, 128)
+	return v.err()
 }
@@ -0,0 +1,425 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxCallbackDataSize is the maximum size of InlineKeyboardButton.CallbackData.
+const MaxCallbackDataSize = 64
+
+var (
+	// ErrCallbackDataTooLong is returned when an encoded payload exceeds
+	// MaxCallbackDataSize and there is no CallbackStore to hold it.
+	ErrCallbackDataTooLong = errors.New("telegram: callback data exceeds 64 bytes")
+	// ErrCallbackDataInvalid is returned when the callback data can't be
+	// decoded.
+	ErrCallbackDataInvalid = errors.New("telegram: invalid callback data")
+)
+
+// storedPayloadMark prefixes the key of payloads kept in a CallbackStore.
+const storedPayloadMark = "*"
+
+// CallbackStore keeps payloads that do not fit in the callback data.
+// Implementations must be safe for concurrent use.
+type CallbackStore interface {
+	// Put saves data under key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the data saved under key.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// DefaultCallbackTTL is how long a MemoryCallbackStore keeps payloads by
+// default.
+const DefaultCallbackTTL = 24 * time.Hour
+
+// MemoryCallbackStore is a CallbackStore that keeps payloads in memory.
+//
+// Payloads expire after the TTL, so that a long running bot doesn't keep
+// every payload forever; buttons pressed after that fail to decode. Expired
+// payloads are removed as new ones are saved.
+type MemoryCallbackStore struct {
+	// TTL is how long payloads are kept. Zero means they never expire.
+	TTL time.Duration
+
+	mu        sync.Mutex
+	data      map[string]callbackEntry
+	lastSweep time.Time
+}
+
+type callbackEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// NewMemoryCallbackStore returns an empty MemoryCallbackStore, with payloads
+// expiring after DefaultCallbackTTL.
+func NewMemoryCallbackStore() *MemoryCallbackStore {
+	return &MemoryCallbackStore{
+		TTL:  DefaultCallbackTTL,
+		data: make(map[string]callbackEntry),
+	}
+}
+
+// Put implements the CallbackStore interface.
+func (m *MemoryCallbackStore) Put(ctx context.Context, key string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if m.data == nil {
+		m.data = make(map[string]callbackEntry)
+	}
+	if m.TTL > 0 && now.Sub(m.lastSweep) > m.TTL/10 {
+		m.sweep(now)
+		m.lastSweep = now
+	}
+	e := callbackEntry{data: data}
+	if m.TTL > 0 {
+		e.expires = now.Add(m.TTL)
+	}
+	m.data[key] = e
+	return nil
+}
+
+// Get implements the CallbackStore interface.
+func (m *MemoryCallbackStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	if ok && e.expired(time.Now()) {
+		delete(m.data, key)
+		ok = false
+	}
+	if !ok {
+		return nil, fmt.Errorf("telegram: callback payload %q not found", key)
+	}
+	return e.data, nil
+}
+
+// Len returns the number of payloads kept, including expired ones not
+// removed yet.
+func (m *MemoryCallbackStore) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.data)
+}
+
+// sweep removes the expired payloads. Must be called with m.mu held.
+func (m *MemoryCallbackStore) sweep(now time.Time) {
+	for k, e := range m.data {
+		if e.expired(now) {
+			delete(m.data, k)
+		}
+	}
+}
+
+func (e callbackEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// CallbackCodec packs typed payloads into callback data, in the form
+// namespace:version:payload.
+//
+// Payloads are encoded in a compact binary form, using base64, and can be a
+// bool, integer, float, string, or a struct or slice of those. Payloads that
+// make the callback data larger than MaxCallbackDataSize are saved in the
+// Store, if any, and only a reference is sent to Telegram.
+type CallbackCodec struct {
+	Store CallbackStore
+}
+
+// Encode returns the callback data for payload under namespace and version.
+// The namespace must not contain a colon.
+func (c *CallbackCodec) Encode(ctx context.Context, namespace string, version int, payload interface{}) (string, error) {
+	if strings.Contains(namespace, ":") {
+		return "", fmt.Errorf("telegram: invalid callback namespace %q", namespace)
+	}
+	var buff bytes.Buffer
+	if payload != nil {
+		if err := encodeCallbackValue(&buff, reflect.ValueOf(payload)); err != nil {
+			return "", err
+		}
+	}
+	prefix := namespace + ":" + strconv.Itoa(version) + ":"
+	data := prefix + base64.RawURLEncoding.EncodeToString(buff.Bytes())
+	if len(data) <= MaxCallbackDataSize {
+		return data, nil
+	}
+	if c.Store == nil {
+		return "", ErrCallbackDataTooLong
+	}
+	key := make([]byte, 12)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	k := base64.RawURLEncoding.EncodeToString(key)
+	if err := c.Store.Put(ctx, k, buff.Bytes()); err != nil {
+		return "", err
+	}
+	data = prefix + storedPayloadMark + k
+	if len(data) > MaxCallbackDataSize {
+		return "", ErrCallbackDataTooLong
+	}
+	return data, nil
+}
+
+// Decode parses the callback data produced by Encode.
+func (c *CallbackCodec) Decode(ctx context.Context, data string) (*CallbackData, error) {
+	parts := strings.SplitN(data, ":", 3)
+	if len(parts) != 3 {
+		return nil, ErrCallbackDataInvalid
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, ErrCallbackDataInvalid
+	}
+	var payload []byte
+	if strings.HasPrefix(parts[2], storedPayloadMark) {
+		if c.Store == nil {
+			return nil, ErrCallbackDataInvalid
+		}
+		if payload, err = c.Store.Get(ctx, strings.TrimPrefix(parts[2], storedPayloadMark)); err != nil {
+			return nil, err
+		}
+	} else if payload, err = base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return nil, ErrCallbackDataInvalid
+	}
+	return &CallbackData{Namespace: parts[0], Version: version, payload: payload}, nil
+}
+
+// CallbackData is the decoded form of the callback data produced by a
+// CallbackCodec.
+type CallbackData struct {
+	Namespace string
+	Version   int
+
+	payload []byte
+}
+
+// Payload decodes the payload into v, that must be a pointer to the same
+// type used to encode it.
+func (d *CallbackData) Payload(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("telegram: callback payload must be a non-nil pointer, got %T", v)
+	}
+	r := bytes.NewReader(d.payload)
+	if err := decodeCallbackValue(r, rv.Elem()); err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return ErrCallbackDataInvalid
+	}
+	return nil
+}
+
+func encodeCallbackValue(w *bytes.Buffer, v reflect.Value) error {
+	var tmp [binary.MaxVarintLen64]byte
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return fmt.Errorf("telegram: can't encode nil %v in callback payload", v.Type())
+		}
+		return encodeCallbackValue(w, v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			w.WriteByte(1)
+		} else {
+			w.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w.Write(tmp[:binary.PutVarint(tmp[:], v.Int())])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		w.Write(tmp[:binary.PutUvarint(tmp[:], v.Uint())])
+	case reflect.Float32, reflect.Float64:
+		// Reversed bytes, as in encoding/gob, make common values shorter.
+		w.Write(tmp[:binary.PutUvarint(tmp[:], bits.ReverseBytes64(math.Float64bits(v.Float())))])
+	case reflect.String:
+		w.Write(tmp[:binary.PutUvarint(tmp[:], uint64(v.Len()))])
+		w.WriteString(v.String())
+	case reflect.Slice:
+		w.Write(tmp[:binary.PutUvarint(tmp[:], uint64(v.Len()))])
+		for i := 0; i < v.Len(); i++ {
+			if err := encodeCallbackValue(w, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := encodeCallbackValue(w, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("telegram: unsupported callback payload type %v", v.Type())
+	}
+	return nil
+}
+
+func decodeCallbackValue(r *bytes.Reader, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeCallbackValue(r, v.Elem())
+	case reflect.Bool:
+		b, err := r.ReadByte()
+		if err != nil {
+			return ErrCallbackDataInvalid
+		}
+		v.SetBool(b != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := binary.ReadVarint(r)
+		if err != nil {
+			return ErrCallbackDataInvalid
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return ErrCallbackDataInvalid
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return ErrCallbackDataInvalid
+		}
+		v.SetFloat(math.Float64frombits(bits.ReverseBytes64(n)))
+	case reflect.String:
+		n, err := binary.ReadUvarint(r)
+		if err != nil || n > uint64(r.Len()) {
+			return ErrCallbackDataInvalid
+		}
+		b := make([]byte, n)
+		r.Read(b)
+		v.SetString(string(b))
+	case reflect.Slice:
+		n, err := binary.ReadUvarint(r)
+		if err != nil || n > uint64(r.Len()) {
+			return ErrCallbackDataInvalid
+		}
+		s := reflect.MakeSlice(v.Type(), int(n), int(n))
+		for i := 0; i < int(n); i++ {
+			if err := decodeCallbackValue(r, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := decodeCallbackValue(r, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("telegram: unsupported callback payload type %v", v.Type())
+	}
+	return nil
+}
+
+// CallbackFunc handles a callback query. The data is nil if the query data
+// was not produced by the router CallbackCodec.
+type CallbackFunc func(ctx context.Context, q *CallbackQuery, data *CallbackData)
+
+// CallbackRouter is a Handler that dispatches callback queries to the function
+// registered for the namespace of their data, which is the text before the
+// first colon.
+//
+// Updates without a callback query, or with an unknown namespace, are passed
+// to the default Handler, if any.
+type CallbackRouter struct {
+	codec    *CallbackCodec
+	handlers map[string]CallbackFunc
+	fallback Handler
+}
+
+// NewCallbackRouter returns a CallbackRouter that decodes callback data with
+// codec. If codec is nil, a CallbackCodec without a store is used.
+func NewCallbackRouter(codec *CallbackCodec) *CallbackRouter {
+	if codec == nil {
+		codec = &CallbackCodec{}
+	}
+	return &CallbackRouter{
+		codec:    codec,
+		handlers: make(map[string]CallbackFunc),
+	}
+}
+
+// Handle registers f to handle callback queries in namespace.
+func (r *CallbackRouter) Handle(namespace string, f CallbackFunc) {
+	r.handlers[namespace] = f
+}
+
+// Default registers the Handler for updates without a registered namespace.
+func (r *CallbackRouter) Default(h Handler) {
+	r.fallback = h
+}
+
+// HandleUpdate implements the Handler interface.
+func (r *CallbackRouter) HandleUpdate(ctx context.Context, u *Update) {
+	if q := u.CallbackQuery; q != nil {
+		namespace := q.Data
+		if i := strings.Index(namespace, ":"); i >= 0 {
+			namespace = namespace[:i]
+		}
+		if f, ok := r.handlers[namespace]; ok {
+			data, err := r.codec.Decode(ctx, q.Data)
+			if err != nil {
+				data = nil
+			}
+			f(ctx, q, data)
+			return
+		}
+	}
+	if r.fallback != nil {
+		r.fallback.HandleUpdate(ctx, u)
+	}
+}
+
+// AnswerCallbackQueryParams are the parameters of the answerCallbackQuery
+// method.
+type AnswerCallbackQueryParams struct {
+	// Unique identifier for the query to be answered
+	CallbackQueryId string `json:"callback_query_id"`
+	// Optional. Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
+	Text string `json:"text,omitempty"`
+	// Optional. If true, an alert will be shown by the client instead of a notification at the top of the chat screen. Defaults to false.
+	ShowAlert *bool `json:"show_alert,omitempty"`
+	// Optional. URL that will be opened by the user's client. Only game URLs or t.me/your_bot?start=XXXX links are allowed.
+	Url string `json:"url,omitempty"`
+	// Optional. The maximum amount of time in seconds that the result of the callback query may be cached client-side.
+	CacheTime *int64 `json:"cache_time,omitempty"`
+}
+
+// AnswerCallbackQuery acknowledges a callback query, optionally showing a
+// notification or an alert to the user.
+func (t *ApiClient) AnswerCallbackQuery(p *AnswerCallbackQueryParams) error {
+	return t.AnswerCallbackQueryContext(context.Background(), p)
+}
+
+// AnswerCallbackQueryContext is like AnswerCallbackQuery, but the request is
+// bound to ctx.
+func (t *ApiClient) AnswerCallbackQueryContext(ctx context.Context, p *AnswerCallbackQueryParams) error {
+	var ok bool
+	return t.CallContext(ctx, "POST", "answerCallbackQuery", p, &ok)
+}
@@ -0,0 +1,141 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testPayload struct {
+	Id     int64
+	Page   uint
+	Score  float64
+	Done   bool
+	Name   string
+	Tags   []string
+	hidden string
+}
+
+func TestCallbackCodecRoundTrip(t *testing.T) {
+	c := &CallbackCodec{}
+	ctx := context.Background()
+	in := testPayload{Id: -42, Page: 3, Score: 1.5, Done: true, Name: "a:b", Tags: []string{"x", "y"}, hidden: "h"}
+	data, err := c.Encode(ctx, "list", 2, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) > MaxCallbackDataSize {
+		t.Fatalf("got %d bytes of callback data, want at most %d", len(data), MaxCallbackDataSize)
+	}
+	if !strings.HasPrefix(data, "list:2:") {
+		t.Errorf("got %q, want the list:2: prefix", data)
+	}
+
+	d, err := c.Decode(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Namespace != "list" || d.Version != 2 {
+		t.Errorf("got namespace %q and version %d, want list and 2", d.Namespace, d.Version)
+	}
+	var out testPayload
+	if err := d.Payload(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Id != in.Id || out.Page != in.Page || out.Score != in.Score || out.Done != in.Done || out.Name != in.Name ||
+		len(out.Tags) != 2 || out.Tags[0] != "x" || out.Tags[1] != "y" || out.hidden != "" {
+		t.Errorf("got %+v, want %+v without unexported fields", out, in)
+	}
+}
+
+func TestCallbackCodecStore(t *testing.T) {
+	ctx := context.Background()
+	long := strings.Repeat("a", 100)
+	if _, err := (&CallbackCodec{}).Encode(ctx, "ns", 1, long); err != ErrCallbackDataTooLong {
+		t.Fatalf("got %v without a store, want ErrCallbackDataTooLong", err)
+	}
+
+	store := NewMemoryCallbackStore()
+	c := &CallbackCodec{Store: store}
+	data, err := c.Encode(ctx, "ns", 1, long)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) > MaxCallbackDataSize || !strings.HasPrefix(data, "ns:1:"+storedPayloadMark) {
+		t.Errorf("got %q, want a reference to the store", data)
+	}
+	if store.Len() != 1 {
+		t.Errorf("got %d stored payloads, want 1", store.Len())
+	}
+	d, err := c.Decode(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out string
+	if err := d.Payload(&out); err != nil || out != long {
+		t.Errorf("got %q, %v, want the stored payload", out, err)
+	}
+}
+
+func TestMemoryCallbackStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryCallbackStore()
+	store.TTL = 10 * time.Millisecond
+	if err := store.Put(ctx, "old", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := store.Get(ctx, "old"); err != nil || string(b) != "x" {
+		t.Fatalf("got %q, %v, want the payload before it expires", b, err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := store.Put(ctx, "new", []byte("y")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(ctx, "old"); err == nil {
+		t.Error("got an expired payload, want an error")
+	}
+	if store.Len() != 1 {
+		t.Errorf("got %d stored payloads, want only the new one", store.Len())
+	}
+}
+
+func TestCallbackCodecMalformed(t *testing.T) {
+	ctx := context.Background()
+	c := &CallbackCodec{}
+	for _, data := range []string{
+		"",
+		"ns",
+		"ns:1",
+		"ns:x:AA",
+		"ns:1:!!",
+		"ns:1:" + storedPayloadMark + "key",
+	} {
+		if _, err := c.Decode(ctx, data); err != ErrCallbackDataInvalid {
+			t.Errorf("Decode(%q) got %v, want ErrCallbackDataInvalid", data, err)
+		}
+	}
+
+	// A string longer than the remaining bytes.
+	d, err := c.Decode(ctx, "ns:1:Cg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s string
+	if err := d.Payload(&s); err != ErrCallbackDataInvalid {
+		t.Errorf("got %v, want ErrCallbackDataInvalid", err)
+	}
+	// Trailing bytes after the payload.
+	data, err := c.Encode(ctx, "ns", 1, []int64{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = c.Decode(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int64
+	if err := d.Payload(&n); err != ErrCallbackDataInvalid {
+		t.Errorf("got %v, want ErrCallbackDataInvalid", err)
+	}
+}
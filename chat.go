@@ -0,0 +1,264 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// CreateChatInviteLinkParams are the parameters of the createChatInviteLink
+// method.
+type CreateChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+	// Optional. Point in time when the link will expire
+	ExpireDate time.Time `json:"-"`
+	// Optional. The maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit *int64 `json:"member_limit,omitempty"`
+	// Optional. True, if users joining the chat via the link need to be approved by chat administrators. If True, member_limit can't be specified
+	CreatesJoinRequest *bool `json:"creates_join_request,omitempty"`
+}
+
+// MarshalJSON encodes the params, with ExpireDate as Unix time.
+func (p *CreateChatInviteLinkParams) MarshalJSON() ([]byte, error) {
+	type params CreateChatInviteLinkParams
+	return json.Marshal(&struct {
+		*params
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{
+		params:     (*params)(p),
+		ExpireDate: unixTime(p.ExpireDate),
+	})
+}
+
+// EditChatInviteLinkParams are the parameters of the editChatInviteLink
+// method.
+type EditChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// The invite link to edit
+	InviteLink string `json:"invite_link"`
+	// Optional. Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+	// Optional. Point in time when the link will expire
+	ExpireDate time.Time `json:"-"`
+	// Optional. The maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	MemberLimit *int64 `json:"member_limit,omitempty"`
+	// Optional. True, if users joining the chat via the link need to be approved by chat administrators. If True, member_limit can't be specified
+	CreatesJoinRequest *bool `json:"creates_join_request,omitempty"`
+}
+
+// MarshalJSON encodes the params, with ExpireDate as Unix time.
+func (p *EditChatInviteLinkParams) MarshalJSON() ([]byte, error) {
+	type params EditChatInviteLinkParams
+	return json.Marshal(&struct {
+		*params
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{
+		params:     (*params)(p),
+		ExpireDate: unixTime(p.ExpireDate),
+	})
+}
+
+// Expires returns the date when the link expires, or the zero time if it
+// never expires.
+func (l *ChatInviteLink) Expires() time.Time {
+	return fromUnixTime(int64Value(l.ExpireDate))
+}
+
+// GetChat returns up-to-date information about a chat.
+func (t *ApiClient) GetChat(chatId string) (*ChatFullInfo, error) {
+	return t.GetChatContext(context.Background(), chatId)
+}
+
+// GetChatContext is like GetChat, but the request is bound to ctx.
+func (t *ApiClient) GetChatContext(ctx context.Context, chatId string) (*ChatFullInfo, error) {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	chat := new(ChatFullInfo)
+	if err := t.CallContext(ctx, "POST", "getChat", params, chat); err != nil {
+		return nil, err
+	}
+	return chat, nil
+}
+
+// SetChatTitle changes the title of a chat, with 1-128 characters. The bot
+// must be an administrator with the appropriate rights.
+func (t *ApiClient) SetChatTitle(chatId, title string) error {
+	return t.SetChatTitleContext(context.Background(), chatId, title)
+}
+
+// SetChatTitleContext is like SetChatTitle, but the request is bound to ctx.
+func (t *ApiClient) SetChatTitleContext(ctx context.Context, chatId, title string) error {
+	params := &setChatTitleParams{ChatId: chatId, Title: title}
+	var ok bool
+	return t.CallContext(ctx, "POST", "setChatTitle", params, &ok)
+}
+
+// setChatTitleParams are the parameters of the setChatTitle method.
+type setChatTitleParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// New chat title, 1-128 characters
+	Title string `json:"title"`
+}
+
+// SetChatDescription changes the description of a group, supergroup or
+// channel, with 0-255 characters. The bot must be an administrator with the
+// appropriate rights.
+func (t *ApiClient) SetChatDescription(chatId, description string) error {
+	return t.SetChatDescriptionContext(context.Background(), chatId, description)
+}
+
+// SetChatDescriptionContext is like SetChatDescription, but the request is
+// bound to ctx.
+func (t *ApiClient) SetChatDescriptionContext(ctx context.Context, chatId, description string) error {
+	params := &setChatDescriptionParams{ChatId: chatId, Description: description}
+	var ok bool
+	return t.CallContext(ctx, "POST", "setChatDescription", params, &ok)
+}
+
+// setChatDescriptionParams are the parameters of the setChatDescription
+// method.
+type setChatDescriptionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. New chat description, 0-255 characters
+	Description string `json:"description,omitempty"`
+}
+
+// SetChatPhotoParams are the parameters of the setChatPhoto method.
+type SetChatPhotoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// New chat photo, uploaded using multipart/form-data
+	Photo *InputFile `json:"photo"`
+}
+
+// SetChatPhoto changes the photo of a chat. The bot must be an administrator
+// with the appropriate rights.
+func (t *ApiClient) SetChatPhoto(chatId string, photo *InputFile) error {
+	return t.SetChatPhotoContext(context.Background(), chatId, photo)
+}
+
+// SetChatPhotoContext is like SetChatPhoto, but the request is bound to ctx.
+func (t *ApiClient) SetChatPhotoContext(ctx context.Context, chatId string, photo *InputFile) error {
+	var ok bool
+	return t.callUpload(ctx, "setChatPhoto", &SetChatPhotoParams{ChatId: chatId, Photo: photo}, &ok)
+}
+
+// DeleteChatPhoto deletes the photo of a chat. The bot must be an
+// administrator with the appropriate rights.
+func (t *ApiClient) DeleteChatPhoto(chatId string) error {
+	return t.DeleteChatPhotoContext(context.Background(), chatId)
+}
+
+// DeleteChatPhotoContext is like DeleteChatPhoto, but the request is bound to
+// ctx.
+func (t *ApiClient) DeleteChatPhotoContext(ctx context.Context, chatId string) error {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteChatPhoto", params, &ok)
+}
+
+// ExportChatInviteLink generates a new primary invite link for a chat,
+// revoking the previous one, and returns it.
+func (t *ApiClient) ExportChatInviteLink(chatId string) (string, error) {
+	return t.ExportChatInviteLinkContext(context.Background(), chatId)
+}
+
+// ExportChatInviteLinkContext is like ExportChatInviteLink, but the request
+// is bound to ctx.
+func (t *ApiClient) ExportChatInviteLinkContext(ctx context.Context, chatId string) (string, error) {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+	}
+	var link string
+	if err := t.CallContext(ctx, "POST", "exportChatInviteLink", params, &link); err != nil {
+		return "", err
+	}
+	return link, nil
+}
+
+// CreateChatInviteLink creates an additional invite link for a chat.
+func (t *ApiClient) CreateChatInviteLink(p *CreateChatInviteLinkParams) (*ChatInviteLink, error) {
+	return t.CreateChatInviteLinkContext(context.Background(), p)
+}
+
+// CreateChatInviteLinkContext is like CreateChatInviteLink, but the request
+// is bound to ctx.
+func (t *ApiClient) CreateChatInviteLinkContext(ctx context.Context, p *CreateChatInviteLinkParams) (*ChatInviteLink, error) {
+	return t.callInviteLink(ctx, "createChatInviteLink", p)
+}
+
+// EditChatInviteLink edits a non-primary invite link created by the bot.
+func (t *ApiClient) EditChatInviteLink(p *EditChatInviteLinkParams) (*ChatInviteLink, error) {
+	return t.EditChatInviteLinkContext(context.Background(), p)
+}
+
+// EditChatInviteLinkContext is like EditChatInviteLink, but the request is
+// bound to ctx.
+func (t *ApiClient) EditChatInviteLinkContext(ctx context.Context, p *EditChatInviteLinkParams) (*ChatInviteLink, error) {
+	return t.callInviteLink(ctx, "editChatInviteLink", p)
+}
+
+// RevokeChatInviteLink revokes an invite link created by the bot. If the
+// primary link is revoked, a new one is generated.
+func (t *ApiClient) RevokeChatInviteLink(chatId, inviteLink string) (*ChatInviteLink, error) {
+	return t.RevokeChatInviteLinkContext(context.Background(), chatId, inviteLink)
+}
+
+// RevokeChatInviteLinkContext is like RevokeChatInviteLink, but the request
+// is bound to ctx.
+func (t *ApiClient) RevokeChatInviteLinkContext(ctx context.Context, chatId, inviteLink string) (*ChatInviteLink, error) {
+	params := map[string]interface{}{
+		"chat_id":     chatId,
+		"invite_link": inviteLink,
+	}
+	return t.callInviteLink(ctx, "revokeChatInviteLink", params)
+}
+
+// ApproveChatJoinRequest approves the request of a user to join a chat.
+func (t *ApiClient) ApproveChatJoinRequest(chatId string, userId int64) error {
+	return t.ApproveChatJoinRequestContext(context.Background(), chatId, userId)
+}
+
+// ApproveChatJoinRequestContext is like ApproveChatJoinRequest, but the
+// request is bound to ctx.
+func (t *ApiClient) ApproveChatJoinRequestContext(ctx context.Context, chatId string, userId int64) error {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+		"user_id": userId,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "approveChatJoinRequest", params, &ok)
+}
+
+// DeclineChatJoinRequest declines the request of a user to join a chat.
+func (t *ApiClient) DeclineChatJoinRequest(chatId string, userId int64) error {
+	return t.DeclineChatJoinRequestContext(context.Background(), chatId, userId)
+}
+
+// DeclineChatJoinRequestContext is like DeclineChatJoinRequest, but the
+// request is bound to ctx.
+func (t *ApiClient) DeclineChatJoinRequestContext(ctx context.Context, chatId string, userId int64) error {
+	params := map[string]interface{}{
+		"chat_id": chatId,
+		"user_id": userId,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "declineChatJoinRequest", params, &ok)
+}
+
+func (t *ApiClient) callInviteLink(ctx context.Context, apiMethod string, params interface{}) (*ChatInviteLink, error) {
+	link := new(ChatInviteLink)
+	if err := t.CallContext(ctx, "POST", apiMethod, params, link); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
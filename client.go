@@ -2,12 +2,12 @@ package telegram
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
-	"mime/multipart"
 	"net/http"
 )
 
@@ -19,9 +19,13 @@ const (
 type DebugFunc func(msg string)
 
 type ApiClient struct {
-	client *http.Client
-	token  string
-	debug  DebugFunc
+	client      *http.Client
+	token       string
+	debug       DebugFunc
+	retry       *RetryPolicy
+	limiter     Limiter
+	maxDownload int64
+	validate    bool
 
 	botEndpoint      string
 	downloadEndpoint string
@@ -37,6 +41,7 @@ func NewApiClient(c *http.Client, token string) *ApiClient {
 		client:           c,
 		token:            token,
 		debug:            stderrDebug,
+		maxDownload:      DefaultMaxDownloadSize,
 		botEndpoint:      TelegramBotEndpoint,
 		downloadEndpoint: TelegramFileDownloadEndpoint,
 	}
@@ -60,18 +65,51 @@ func (t *ApiClient) Debugf(msg string, args ...interface{}) {
 	}
 }
 
+// Call invokes the apiMethod using in as the JSON encoded parameters, and
+// decodes the result into out.
 func (t *ApiClient) Call(httpMethod, apiMethod string, in, out interface{}) error {
-	var buff bytes.Buffer
-	if err := json.NewEncoder(&buff).Encode(in); err != nil {
+	return t.CallContext(context.Background(), httpMethod, apiMethod, in, out)
+}
+
+// CallContext is like Call, but the request is bound to ctx.
+func (t *ApiClient) CallContext(ctx context.Context, httpMethod, apiMethod string, in, out interface{}) error {
+	if err := t.validateParams(in); err != nil {
 		return err
 	}
+	var body []byte
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = b
+	}
 	url := t.endpoint(apiMethod)
-	req, err := http.NewRequest(httpMethod, url, &buff)
-	if err != nil {
-		return err
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.wait(ctx, apiMethod, bodyChatId(body)); err != nil {
+				return err
+			}
+		}
+		req, err := http.NewRequest(httpMethod, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req = req.WithContext(ctx)
+		req.Header.Set("Content-Type", "application/json")
+		err = t.makeRequest(req, out)
+		if err == nil {
+			return nil
+		}
+		wait, next, ok := t.retryBody(apiMethod, body, attempt, err)
+		if !ok {
+			return err
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+		body = next
 	}
-	req.Header.Set("Content-Type", "application/json")
-	return t.makeRequest(req, out)
 }
 
 func (t *ApiClient) endpoint(apiMethod string) string {
@@ -91,17 +129,20 @@ func (t *ApiClient) makeRequest(req *http.Request, out interface{}) error {
 	}
 	t.Debugf("* API Response: %v", string(b))
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return fmt.Errorf("telegram: unexpected response: %v: %v [%s]", resp.StatusCode, resp.Status, string(b))
-	}
-
 	// Check if operation suceeded
 	apiResp := new(ApiResponse)
 	if err := json.Unmarshal(b, apiResp); err != nil {
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return &APIError{
+				StatusCode:  resp.StatusCode,
+				ErrorCode:   resp.StatusCode,
+				Description: resp.Status,
+			}
+		}
 		return fmt.Errorf("telegram: unable to parse response %v", err)
 	}
-	if !apiResp.OK {
-		return fmt.Errorf("telegram: operation failed: %s", string(b))
+	if !apiResp.OK || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp.StatusCode, apiResp)
 	}
 
 	return json.Unmarshal(apiResp.Result, out)
@@ -109,58 +150,48 @@ func (t *ApiClient) makeRequest(req *http.Request, out interface{}) error {
 
 // GetFile prepares a file for download.
 func (t *ApiClient) GetFile(fileId string) (*File, error) {
+	return t.GetFileContext(context.Background(), fileId)
+}
+
+// GetFileContext is like GetFile, but the request is bound to ctx.
+func (t *ApiClient) GetFileContext(ctx context.Context, fileId string) (*File, error) {
 	params := map[string]string{
 		"file_id": fileId,
 	}
 	t.Debugf("Fetching file with getFile: %v", fileId)
 	file := new(File)
-	if err := t.Call("GET", "getFile", params, file); err != nil {
+	if err := t.CallContext(ctx, "GET", "getFile", params, file); err != nil {
 		return nil, err
 	}
 	return file, nil
 }
 
-// DownloadFile fetches the file from f.FilePath and writes the content into w.
-func (t *ApiClient) DownloadFile(f *File, w io.Writer) error {
-	// https://api.telegram.org/file/bot<token>/<file_path>
-	url := fmt.Sprintf("%s%s/%s", t.downloadEndpoint, t.token, f.FilePath)
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return err
-	}
-	resp, err := t.client.Do(req)
-	if err != nil {
-		return err
-	}
-	_, err = io.Copy(w, resp.Body)
-	return err
-}
-
 // SendMessage sends a plain text message to the provided recipient.
 func (t *ApiClient) SendMessage(to, text string) (*Message, error) {
-	params := map[string]string{
-		"chat_id": to,
-		"text":    text,
-	}
-	msg := new(Message)
-	if err := t.Call("POST", "sendMessage", params, msg); err != nil {
-		return nil, err
-	}
-	return msg, nil
+	return t.SendMessageContext(context.Background(), to, text)
+}
+
+// SendMessageContext is like SendMessage, but the request is bound to ctx.
+func (t *ApiClient) SendMessageContext(ctx context.Context, to, text string) (*Message, error) {
+	return t.SendContext(ctx, &SendMessageParams{
+		ChatId: to,
+		Text:   text,
+	})
 }
 
 // SendFormattedMessage sends a formatted message in either HTML or Markdown.
 func (t *ApiClient) SendFormattedMessage(to, text string, parseMode ParseMode) (*Message, error) {
-	params := map[string]string{
-		"chat_id":    to,
-		"text":       text,
-		"parse_mode": string(parseMode),
-	}
-	msg := new(Message)
-	if err := t.Call("POST", "sendMessage", params, msg); err != nil {
-		return nil, err
-	}
-	return msg, nil
+	return t.SendFormattedMessageContext(context.Background(), to, text, parseMode)
+}
+
+// SendFormattedMessageContext is like SendFormattedMessage, but the request is
+// bound to ctx.
+func (t *ApiClient) SendFormattedMessageContext(ctx context.Context, to, text string, parseMode ParseMode) (*Message, error) {
+	return t.SendContext(ctx, &SendMessageParams{
+		ChatId:    to,
+		Text:      text,
+		ParseMode: parseMode,
+	})
 }
 
 // SendMessagef calls fmt.Sprintf and passes the resulting message to SendMessage.
@@ -168,84 +199,59 @@ func (t *ApiClient) SendMessagef(to, formatText string, args ...interface{}) (*M
 	return t.SendMessage(to, fmt.Sprintf(formatText, args...))
 }
 
-func (t *ApiClient) SendMessageKeyboard(to string, text string, keyboard interface{}) (*Message, error) {
-	params := map[string]interface{}{
-		"chat_id":      to,
-		"text":         text,
-		"reply_markup": keyboard,
-	}
-	msg := new(Message)
-	if err := t.Call("POST", "sendMessage", params, msg); err != nil {
-		return nil, err
-	}
-	return msg, nil
+// SendMessagefContext calls fmt.Sprintf and passes the resulting message to
+// SendMessageContext.
+func (t *ApiClient) SendMessagefContext(ctx context.Context, to, formatText string, args ...interface{}) (*Message, error) {
+	return t.SendMessageContext(ctx, to, fmt.Sprintf(formatText, args...))
 }
 
-func (t *ApiClient) SendPhotoURL(to string, text string, photo string) (*Message, error) {
-	params := map[string]interface{}{
-		"chat_id": to,
-		"caption": text,
-		"photo": photo,
-	}
-	msg := new(Message)
-	if err := t.Call("POST", "sendPhoto", params, msg); err != nil {
-		return nil, err
-	}
-	return msg, nil
+func (t *ApiClient) SendMessageKeyboard(to string, text string, keyboard ReplyMarkup) (*Message, error) {
+	return t.SendMessageKeyboardContext(context.Background(), to, text, keyboard)
 }
 
-func (t *ApiClient) SendPhotoFromReader(to string, text string, photo io.Reader) (*Message, error) {
-	// Send photo from reader
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-	fw, err := w.CreateFormFile("photo", "photo.png")
-	if err != nil {
-		return nil, err
-	}
-	if _, err := io.Copy(fw, photo); err != nil {
-		return nil, err
-	}
+// SendMessageKeyboardContext is like SendMessageKeyboard, but the request is
+// bound to ctx.
+func (t *ApiClient) SendMessageKeyboardContext(ctx context.Context, to string, text string, keyboard ReplyMarkup) (*Message, error) {
+	return t.SendContext(ctx, &SendMessageParams{
+		ChatId:      to,
+		Text:        text,
+		ReplyMarkup: keyboard,
+	})
+}
 
-	if fw, err = w.CreateFormField("chat_id"); err != nil {
-		return nil, err
-	}
-	if _, err = fw.Write([]byte(to)); err != nil {
-		return nil, err
-	}
+func (t *ApiClient) SendPhotoURL(to string, text string, photo string) (*Message, error) {
+	return t.SendPhotoURLContext(context.Background(), to, text, photo)
+}
 
-	if fw, err = w.CreateFormField("caption"); err != nil {
-		return nil, err
-	}
-	if _, err = fw.Write([]byte(text)); err != nil {
-		return nil, err
-	}
+// SendPhotoURLContext is like SendPhotoURL, but the request is bound to ctx.
+func (t *ApiClient) SendPhotoURLContext(ctx context.Context, to string, text string, photo string) (*Message, error) {
+	return t.SendPhotoContext(ctx, &SendPhotoParams{
+		ChatId:  to,
+		Caption: text,
+		Photo:   FileURL(photo),
+	})
+}
 
-	// Send raw form data
-	url := t.endpoint("sendPhoto")
-	req, err := http.NewRequest("POST", url, &b)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", w.FormDataContentType())
-	msg := new(Message)
-	if err = t.makeRequest(req, msg); err != nil {
-		return nil, err
-	}
-	return msg, nil
+func (t *ApiClient) SendPhotoFromReader(to string, text string, photo io.Reader) (*Message, error) {
+	return t.SendPhotoFromReaderContext(context.Background(), to, text, photo)
 }
 
-// SetWebhook method configures the provided HTTPS endpoint as the bot callback.
-func (t *ApiClient) SetWebhook(httpsURL string) error {
-	out := make(json.RawMessage, 0)
-	if err := t.Call("POST", fmt.Sprintf("setWebhook?url=%s", httpsURL), nil, out); err != nil {
-		return err
-	}
-	return nil
+// SendPhotoFromReaderContext is like SendPhotoFromReader, but the upload is
+// bound to ctx. Use SendPhoto with FileReader to control the file name.
+func (t *ApiClient) SendPhotoFromReaderContext(ctx context.Context, to string, text string, photo io.Reader) (*Message, error) {
+	return t.SendPhotoContext(ctx, &SendPhotoParams{
+		ChatId:  to,
+		Caption: text,
+		Photo:   FileReader("photo.png", photo),
+	})
 }
 
 // ApiResponse is the response API wrapper.
 type ApiResponse struct {
-	OK      bool            `json:"ok"`
-	Result  json.RawMessage `json:"result"`
-	Message string          `json:"message"`
+	OK          bool                `json:"ok"`
+	Result      json.RawMessage     `json:"result"`
+	Message     string              `json:"message"`
+	ErrorCode   int                 `json:"error_code"`
+	Description string              `json:"description"`
+	Parameters  *ResponseParameters `json:"parameters"`
 }
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// enum is a string type with a fixed set of values, listed in the field
+// description, as in "Type of chat, can be either “private”, “group”,
+// “supergroup” or “channel”".
+type enum struct {
+	name    string
+	values  []string
+	written bool
+}
+
+var (
+	reEnumList   = regexp.MustCompile(`(?i)\b(?:can be(?: either)?|one of)\s+(.*)`)
+	reEnumQuoted = regexp.MustCompile(`“([^”]+)”`)
+	reEnumNote   = regexp.MustCompile(`\s*\([^)]*\)`)
+	reEnumValue  = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// enumValues returns the values listed in the description of a String
+// field, or nil if it doesn't list at least two valid identifiers.
+func enumValues(help string) []string {
+	m := reEnumList.FindStringSubmatch(help)
+	if m == nil {
+		return nil
+	}
+	list := m[1]
+	var values []string
+	if quoted := reEnumQuoted.FindAllStringSubmatch(list, -1); quoted != nil {
+		for _, q := range quoted {
+			values = append(values, q[1])
+		}
+	} else {
+		// Unquoted lists, as in "Can be mention (@username), hashtag, ...".
+		if i := strings.Index(list, ". "); i >= 0 {
+			list = list[:i]
+		}
+		list = reEnumNote.ReplaceAllString(strings.TrimSuffix(list, "."), "")
+		list = strings.Replace(list, " or ", ", ", -1)
+		for _, v := range strings.Split(list, ", ") {
+			values = append(values, strings.TrimSpace(v))
+		}
+	}
+	if len(values) < 2 {
+		return nil
+	}
+	for _, v := range values {
+		if !reEnumValue.MatchString(v) {
+			return nil
+		}
+	}
+	return values
+}
+
+// prepareEnums finds the enum fields of the types, naming each enum after
+// the first type and field that use it, as in ChatType. Fields with the same
+// values share the enum.
+func (g *generator) prepareEnums(types []*typeDef) {
+	byValues := make(map[string]*enum)
+	for _, t := range types {
+		if g.types[t.Name] {
+			continue
+		}
+		for _, f := range t.Fields {
+			if f.Type != "String" {
+				continue
+			}
+			values := enumValues(f.Help)
+			if values == nil {
+				continue
+			}
+			key := strings.Join(values, ",")
+			e, ok := byValues[key]
+			if !ok {
+				e = &enum{name: t.Name + goFieldName(f.Name), values: values}
+				byValues[key] = e
+			}
+			g.enums[t.Name+"."+f.Name] = e
+		}
+	}
+}
+
+// writeEnums writes the enums used by t that were not written yet.
+func (g *generator) writeEnums(t *typeDef) {
+	for _, f := range t.Fields {
+		e := g.enums[t.Name+"."+f.Name]
+		if e == nil || e.written {
+			continue
+		}
+		e.written = true
+		g.printf("// %s is a value of the %s field of %s.\n", e.name, f.Name, t.Name)
+		g.printf("type %s string\n\n", e.name)
+		g.printf("const (\n")
+		for _, v := range e.values {
+			g.printf("\t%s%s %s = %q\n", e.name, goFieldName(v), e.name, v)
+		}
+		g.printf(")\n\n")
+	}
+}
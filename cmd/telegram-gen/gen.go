@@ -1,66 +1,312 @@
+// Command telegram-gen generates the Go types and methods of the Telegram Bot
+// API.
+//
+// It reads either the tab-separated api.txt description or a saved copy of
+// the HTML documentation at https://core.telegram.org/bots/api, from the file
+// named in the command line or from the standard input, and writes the Go
+// source to the standard output:
+//
+//	telegram-gen api.txt | gofmt > api.gen.go
+//	telegram-gen botapi.html | gofmt > api.gen.go
+//
+// The api.txt file in the package directory is the source of truth for
+// api.gen.go. It is kept in the repository, so that the generated code can
+// be reviewed and regenerated without network access, and is reduced to
+// what the package covers. The HTML mode is for refreshing it from a new
+// version of the documentation, comparing the output with api.gen.go; the
+// saved documentation is not committed, as it changes with every release.
+//
+// Types and methods already declared by hand in the package directory, set
+// with the -pkg flag, are not generated.
+//
+// Params structs get a Validate method checking the limits documented in
+// their parameters, such as "1-64 bytes" or "0-200 characters", including
+// the params structs declared by hand, from their field comments.
 package main
 
 import (
 	"bytes"
-	"bufio"
+	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
 	"os"
+	"sort"
 	"strings"
 )
 
+// spec is the description of the Bot API types and methods.
+type spec struct {
+	Types   []*typeDef
+	Methods []*methodDef
+}
+
+// typeDef is an API type. Union types have Variants instead of Fields.
+type typeDef struct {
+	Name     string
+	Doc      string
+	Fields   []*fieldDef
+	Variants []string
+}
+
+// methodDef is an API method.
+type methodDef struct {
+	Name    string
+	Doc     string
+	Returns string
+	Params  []*fieldDef
+}
+
+// fieldDef is a field of a type or a parameter of a method. GoType is set
+// for the fields declared by hand.
+type fieldDef struct {
+	Name     string
+	Type     string
+	Required bool
+	Help     string
+	GoType   string
+}
+
 func main() {
-	parsePayloadDesc(os.Stdin)
-}
-
-func parsePayloadDesc(in io.Reader) {
-	fmt.Printf("package telegram\n\n")
-	scaner := bufio.NewScanner(in)
-	for scaner.Scan() {
-		line := scaner.Text()
-		
-		var field, ftype, help string
-		
-		parts := strings.Split(line, "\t")
-		switch {
-		case len(parts) == 1:
-			name := parts[0]
-			if strings.TrimSpace(name) == "" {
-				fmt.Printf("}\n\n")
-			} else {
-				// We are opening a type
-				fmt.Printf("type %s struct {\n", parts[0])
+	log.SetFlags(0)
+	log.SetPrefix("telegram-gen: ")
+	pkg := flag.String("pkg", ".", "directory of the telegram package, to skip hand written declarations")
+	flag.Parse()
+
+	in := io.Reader(os.Stdin)
+	if flag.NArg() > 0 {
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		in = f
+	}
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var s *spec
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("<")) {
+		s, err = parseHTML(b)
+	} else {
+		s, err = parseText(bytes.NewReader(b))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := newGenerator()
+	if err := g.scanPackage(*pkg); err != nil {
+		log.Fatal(err)
+	}
+	g.generate(s)
+	if _, err := g.WriteTo(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generator writes the Go source for a spec.
+type generator struct {
+	body    bytes.Buffer
+	imports map[string]bool
+
+	// types, interfaces and methods are the names declared by hand.
+	types      map[string]bool
+	interfaces map[string]bool
+	methods    map[string]bool
+
+	// unions are the generated union types, and variants the unions of
+	// each variant type.
+	unions   map[string]*union
+	variants map[string][]*union
+
+	// enums are the enums of each field, keyed by "Type.field".
+	enums map[string]*enum
+
+	// uploads are the types that can hold InputFile uploads, as InputMedia.
+	uploads map[string]bool
+
+	// params are the fields of the params structs declared by hand, and
+	// validates the ones with a Validate method declared by hand.
+	params    map[string][]*fieldDef
+	validates map[string]bool
+}
+
+func newGenerator() *generator {
+	return &generator{
+		imports:    make(map[string]bool),
+		types:      make(map[string]bool),
+		interfaces: make(map[string]bool),
+		methods:    make(map[string]bool),
+		unions:     make(map[string]*union),
+		variants:   make(map[string][]*union),
+		enums:      make(map[string]*enum),
+		uploads:    make(map[string]bool),
+		params:     make(map[string][]*fieldDef),
+		validates:  make(map[string]bool),
+	}
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.body, format, args...)
+}
+
+// WriteTo writes the generated file to w.
+func (g *generator) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "package telegram\n\n")
+	if len(g.imports) > 0 {
+		var imports []string
+		for i := range g.imports {
+			imports = append(imports, i)
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(&b, "import (\n")
+		for _, i := range imports {
+			fmt.Fprintf(&b, "\t%q\n", i)
+		}
+		fmt.Fprintf(&b, ")\n\n")
+	}
+	b.Write(g.body.Bytes())
+	return b.WriteTo(w)
+}
+
+func (g *generator) generate(s *spec) {
+	for _, t := range s.Types {
+		if len(t.Variants) > 0 {
+			g.interfaces[t.Name] = true
+		}
+	}
+	g.prepareUnions(s)
+	g.prepareEnums(s.Types)
+	g.prepareUploads(s.Types)
+	g.writeTypes(s.Types)
+	g.writeMethods(s.Methods)
+	g.writeParamsValidate()
+}
+
+// writeTypes writes the types that are not declared by hand.
+func (g *generator) writeTypes(types []*typeDef) {
+	for _, t := range types {
+		if g.types[t.Name] {
+			continue
+		}
+		g.writeEnums(t)
+		g.writeDoc("", typeDoc(t))
+		if len(t.Variants) > 0 {
+			g.writeUnion(g.unions[t.Name])
+			continue
+		}
+		g.printf("type %s struct {\n", t.Name)
+		for _, f := range t.Fields {
+			tag := f.Name
+			if !f.Required {
+				tag += ",omitempty"
 			}
+			g.printf("\t// %s\n", f.Help)
+			g.printf("\t%s %s `json:\"%s\"`\n", goFieldName(f.Name), g.fieldType(t.Name, f), tag)
+		}
+		g.printf("}\n\n")
+		g.writeVariantMarshal(t)
+		g.writeUnmarshal(t)
+	}
+}
+
+// typeDoc returns the documentation of t, starting with its name.
+func typeDoc(t *typeDef) string {
+	if strings.HasPrefix(t.Doc, "This object ") {
+		return t.Name + strings.TrimPrefix(t.Doc, "This object")
+	}
+	if t.Doc == "" && len(t.Variants) > 0 {
+		return t.Name + " is one of its variant types, such as " + t.Variants[0] + "."
+	}
+	for _, verb := range []string{"Represents ", "Describes ", "Contains "} {
+		if strings.HasPrefix(t.Doc, verb) {
+			return t.Name + " " + strings.ToLower(verb[:1]) + t.Doc[1:]
+		}
+	}
+	return t.Doc
+}
+
+// writeDoc writes doc as a comment, with each line prefixed by indent.
+func (g *generator) writeDoc(indent, doc string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		if line == "" {
+			g.printf("%s//\n", indent)
 			continue
-		case len(parts) == 3:
-			field, ftype, help = parts[0], parts[1], parts[2]
-			fmt.Printf("\t// %s\n", help)
-			fmt.Printf("\t%s %s `json:\"%s,omitempty\"`\n", goFieldName(field), goFieldType(ftype), field)
 		}
+		g.printf("%s// %s\n", indent, line)
 	}
 }
 
-func goFieldType(ftype string) string {
+// replyMarkup is the type of the reply_markup parameters that accept any
+// kind of keyboard, declared as the ReplyMarkup interface.
+const replyMarkup = "InlineKeyboardMarkup or ReplyKeyboardMarkup or ReplyKeyboardRemove or ForceReply"
+
+func (g *generator) goType(ftype string) string {
 	switch ftype {
-	case "Integer":
+	case "Integer", "Int":
 		return "int64"
-	case "String":
+	case "String", "Integer or String":
 		return "string"
-	case "Float":
+	case "Float", "Float number":
 		return "float64"
-	case "Boolean","True","False":
+	case "Boolean", "True", "False":
 		return "bool"
+	case "InputFile", "InputFile or String":
+		return "*InputFile"
+	case replyMarkup:
+		return "ReplyMarkup"
 	default:
-		if strings.HasPrefix(ftype, "Array of Array of") {
-			return strings.Replace(ftype, "Array of Array of ", "[][]*", -1)
-		} else if strings.HasPrefix(ftype, "Array of") {
-			return strings.Replace(ftype, "Array of ", "[]*", -1)
+		if strings.HasPrefix(ftype, "Array of ") {
+			return "[]" + g.goType(strings.TrimPrefix(ftype, "Array of "))
+		} else if strings.Contains(ftype, " or ") || strings.Contains(ftype, " and ") || strings.Contains(ftype, ", ") {
+			return "interface{}"
+		} else if fallback, ok := unionFallback[ftype]; ok {
+			return g.goType(fallback)
+		} else if g.interfaces[ftype] {
+			return ftype
 		} else {
 			return "*" + ftype
 		}
 	}
 }
 
+// fieldType returns the Go type of the field f of the type or params struct
+// named owner. Optional Boolean, Integer and Float fields are pointers, so
+// that false and 0 are sent when set and omitted otherwise. Parse modes are
+// ParseMode values.
+func (g *generator) fieldType(owner string, f *fieldDef) string {
+	if f.GoType != "" {
+		return f.GoType
+	}
+	if e := g.enums[owner+"."+f.Name]; e != nil {
+		return e.name
+	}
+	if f.Type == "String" && (f.Name == "parse_mode" || strings.HasSuffix(f.Name, "_parse_mode")) {
+		return "ParseMode"
+	}
+	goType := g.goType(f.Type)
+	if !f.Required && f.Type != "True" {
+		switch goType {
+		case "bool", "int64", "float64":
+			return "*" + goType
+		}
+	}
+	return goType
+}
+
+// isOptional reports whether a field description marks it as optional.
+func isOptional(help string) bool {
+	return strings.HasPrefix(help, "Optional.")
+}
+
 func goFieldName(field string) string {
 	names := strings.Split(field, "_")
 	var buff bytes.Buffer
@@ -68,4 +314,4 @@ func goFieldName(field string) string {
 		buff.WriteString(strings.Title(n))
 	}
 	return buff.String()
-}
\ No newline at end of file
+}
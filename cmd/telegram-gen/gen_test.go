@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerateHTML(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "api.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := parseHTML(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGenerator()
+	g.generate(s)
+	var got bytes.Buffer
+	if _, err := g.WriteTo(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "api.html.golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, got.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Errorf("generated code differs from %s, run go test -update to see the changes:\n%s", golden, got.Bytes())
+	}
+}
+
+func TestParseTextLeadingTab(t *testing.T) {
+	s, err := parseText(strings.NewReader("\tString\tNo name\n\ngetMe\tUser\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Methods) != 1 || s.Methods[0].Name != "getMe" {
+		t.Errorf("got methods %v, want getMe", s.Methods)
+	}
+}
+
+func TestParseHTMLUnion(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "api.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := parseHTML(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGenerator()
+	g.generate(s)
+	u := g.unions["ChatMember"]
+	if u == nil {
+		t.Fatal("got no ChatMember union")
+	}
+	if u.field != "status" {
+		t.Errorf("got discriminator %q, want status", u.field)
+	}
+	for name, want := range map[string]string{
+		"ChatMemberOwner":         "creator",
+		"ChatMemberAdministrator": "administrator",
+	} {
+		if got := u.values[name]; got != want {
+			t.Errorf("got %s status %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestUnionKeys(t *testing.T) {
+	s, err := parseText(strings.NewReader(`Result	ResultPhoto or ResultCachedPhoto or ResultArticle
+
+ResultPhoto
+type	String	Type of the result, must be photo
+photo_url	String	A valid URL for the photo
+
+ResultCachedPhoto
+type	String	Type of the result, must be photo
+photo_file_id	String	A valid file identifier of the photo
+
+ResultArticle
+type	String	Type of the result, must be article
+title	String	Title of the result
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGenerator()
+	g.generate(s)
+	u := g.unions["Result"]
+	if u == nil || u.field != "type" {
+		t.Fatalf("got union %+v, want the type discriminator", u)
+	}
+	if u.keys["ResultPhoto"] != "photo_url" || u.keys["ResultCachedPhoto"] != "" || u.keys["ResultArticle"] != "" {
+		t.Errorf("got keys %v, want photo_url for ResultPhoto only", u.keys)
+	}
+}
+
+func TestFieldType(t *testing.T) {
+	g := newGenerator()
+	for _, tc := range []struct {
+		f    *fieldDef
+		want string
+	}{
+		{&fieldDef{Name: "parse_mode", Type: "String"}, "ParseMode"},
+		{&fieldDef{Name: "quote_parse_mode", Type: "String"}, "ParseMode"},
+		{&fieldDef{Name: "disable_notification", Type: "Boolean"}, "*bool"},
+		{&fieldDef{Name: "latitude", Type: "Float", Required: true}, "float64"},
+		{&fieldDef{Name: "is_bot", Type: "True"}, "bool"},
+	} {
+		if got := g.fieldType("SendMessageParams", tc.f); got != tc.want {
+			t.Errorf("fieldType(%s) = %s, want %s", tc.f.Name, got, tc.want)
+		}
+	}
+}
+
+func TestHasUpload(t *testing.T) {
+	s, err := parseText(strings.NewReader(`Media	MediaPhoto or MediaText
+
+MediaPhoto
+type	String	Type of the media, must be photo
+media	InputFile or String	File to send
+
+MediaText
+type	String	Type of the media, must be text
+text	String	Text to send
+
+sendAlbum	True	sends an album.
+media	Array of Media	Yes	Media to send
+
+sendText	True	sends a text.
+text	String	Yes	Text to send
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGenerator()
+	g.generate(s)
+	for i, want := range []bool{true, false} {
+		if got := g.hasUpload(s.Methods[i]); got != want {
+			t.Errorf("hasUpload(%s) = %v, want %v", s.Methods[i].Name, got, want)
+		}
+	}
+}
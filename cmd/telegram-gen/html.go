@@ -0,0 +1,204 @@
+package main
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	reHeading   = regexp.MustCompile(`(?s)<h([34])(?:\s[^>]*)?>(.*?)</h[34]>`)
+	reParagraph = regexp.MustCompile(`(?s)<p(?:\s[^>]*)?>(.*?)</p>`)
+	reTable     = regexp.MustCompile(`(?s)<table(?:\s[^>]*)?>(.*?)</table>`)
+	reRow       = regexp.MustCompile(`(?s)<tr(?:\s[^>]*)?>(.*?)</tr>`)
+	reCell      = regexp.MustCompile(`(?s)<t[hd](?:\s[^>]*)?>(.*?)</t[hd]>`)
+	reList      = regexp.MustCompile(`(?s)<ul(?:\s[^>]*)?>(.*?)</ul>`)
+	reItem      = regexp.MustCompile(`(?s)<li(?:\s[^>]*)?>(.*?)</li>`)
+	reImage     = regexp.MustCompile(`<img[^>]*\salt="([^"]*)"[^>]*>`)
+	reBreak     = regexp.MustCompile(`<br\s*/?>`)
+	reTag       = regexp.MustCompile(`<[^>]*>`)
+	reSpace     = regexp.MustCompile(`\s+`)
+
+	reTypeName   = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	reMethodName = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+
+	reSentence    = regexp.MustCompile(`\.\s+|\n`)
+	reWord        = regexp.MustCompile(`\w+`)
+	reReturnArray = regexp.MustCompile(`(?i)\barray of (\w+)`)
+)
+
+// parseHTML parses a saved copy of the Bot API documentation page.
+//
+// Each type and method is documented in a section under a h4 heading with
+// its name. Types have a Field, Type and Description table, and methods a
+// Parameter, Type, Required and Description table. Union types have a list
+// of their variants instead.
+func parseHTML(b []byte) (*spec, error) {
+	doc := string(b)
+	s := new(spec)
+	headings := reHeading.FindAllStringSubmatchIndex(doc, -1)
+	for i, h := range headings {
+		if doc[h[2]:h[3]] != "4" {
+			continue
+		}
+		name := htmlText(doc[h[4]:h[5]])
+		end := len(doc)
+		if i+1 < len(headings) {
+			end = headings[i+1][0]
+		}
+		section := doc[h[1]:end]
+		switch {
+		case reTypeName.MatchString(name):
+			if t := parseHTMLType(name, section); t != nil {
+				s.Types = append(s.Types, t)
+			}
+		case reMethodName.MatchString(name):
+			if m := parseHTMLMethod(name, section); m != nil {
+				s.Methods = append(s.Methods, m)
+			}
+		}
+	}
+
+	types := map[string]bool{"True": true, "String": true, "Int": true}
+	for _, t := range s.Types {
+		types[t.Name] = true
+	}
+	for _, m := range s.Methods {
+		m.Returns = returnType(m.Doc, types)
+	}
+	return s, nil
+}
+
+func parseHTMLType(name, section string) *typeDef {
+	t := &typeDef{Name: name, Doc: sectionDoc(section)}
+	rows := tableRows(section)
+	switch {
+	case len(rows) > 0 && rows[0][0] == "Field":
+		for _, r := range rows[1:] {
+			if len(r) < 3 {
+				continue
+			}
+			t.Fields = append(t.Fields, &fieldDef{Name: r[0], Type: r[1], Required: !isOptional(r[2]), Help: r[2]})
+		}
+	case len(rows) == 0:
+		t.Variants = unionVariants(section)
+		if len(t.Variants) == 0 && !strings.Contains(t.Doc, "holds no information") {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return t
+}
+
+func parseHTMLMethod(name, section string) *methodDef {
+	m := &methodDef{Name: name, Doc: sectionDoc(section)}
+	rows := tableRows(section)
+	switch {
+	case len(rows) > 0 && rows[0][0] == "Parameter":
+		for _, r := range rows[1:] {
+			if len(r) < 4 {
+				continue
+			}
+			m.Params = append(m.Params, &fieldDef{Name: r[0], Type: r[1], Required: r[2] == "Yes", Help: r[3]})
+		}
+	case len(rows) == 0 && strings.Contains(m.Doc, "Requires no parameters"):
+	default:
+		return nil
+	}
+	return m
+}
+
+// returnType returns the return type of a method from its description, with
+// sentences such as "On success, the sent Message is returned." or "Returns
+// an Array of Update objects.". Methods that return either a type or True
+// have the type "X or True". It returns an empty string if it is unknown.
+func returnType(doc string, types map[string]bool) string {
+	for _, sentence := range reSentence.Split(doc, -1) {
+		if !strings.Contains(strings.ToLower(sentence), "return") {
+			continue
+		}
+		if m := reReturnArray.FindStringSubmatch(sentence); m != nil && types[m[1]] {
+			return "Array of " + m[1]
+		}
+		var found []string
+		for _, w := range reWord.FindAllString(sentence, -1) {
+			if types[w] && (len(found) == 0 || found[len(found)-1] != w) {
+				found = append(found, w)
+			}
+		}
+		switch {
+		case len(found) == 2 && found[1] == "True":
+			return found[0] + " or True"
+		case len(found) > 0:
+			return found[0]
+		}
+	}
+	return ""
+}
+
+// sectionDoc returns the text of the paragraphs before the first table or
+// list of the section, separated by blank lines.
+func sectionDoc(section string) string {
+	if i := strings.Index(section, "<table"); i >= 0 {
+		section = section[:i]
+	}
+	if i := strings.Index(section, "<ul"); i >= 0 {
+		section = section[:i]
+	}
+	var paragraphs []string
+	for _, p := range reParagraph.FindAllStringSubmatch(section, -1) {
+		if text := htmlText(p[1]); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// tableRows returns the text of the cells of the first table of the section,
+// including the header row.
+func tableRows(section string) [][]string {
+	table := reTable.FindStringSubmatch(section)
+	if table == nil {
+		return nil
+	}
+	var rows [][]string
+	for _, r := range reRow.FindAllStringSubmatch(table[1], -1) {
+		var cells []string
+		for _, c := range reCell.FindAllStringSubmatch(r[1], -1) {
+			cells = append(cells, htmlText(c[1]))
+		}
+		if len(cells) > 0 {
+			rows = append(rows, cells)
+		}
+	}
+	return rows
+}
+
+// unionVariants returns the type names listed in the first list of the
+// section, or nil if it is not a list of types.
+func unionVariants(section string) []string {
+	list := reList.FindStringSubmatch(section)
+	if list == nil {
+		return nil
+	}
+	var variants []string
+	for _, item := range reItem.FindAllStringSubmatch(list[1], -1) {
+		name := htmlText(item[1])
+		if !reTypeName.MatchString(name) {
+			return nil
+		}
+		variants = append(variants, name)
+	}
+	return variants
+}
+
+// htmlText returns the plain text of an HTML fragment, using the alternate
+// text of images such as emoji.
+func htmlText(s string) string {
+	s = reImage.ReplaceAllString(s, "$1")
+	s = reBreak.ReplaceAllString(s, " ")
+	s = reTag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	return strings.TrimSpace(reSpace.ReplaceAllString(s, " "))
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"log"
+	"strings"
+)
+
+// writeMethods writes a params struct and the ApiClient methods for each API
+// method that is not declared by hand.
+func (g *generator) writeMethods(methods []*methodDef) {
+	for _, m := range methods {
+		name := goFieldName(m.Name)
+		if g.methods[name] || g.methods[name+"Context"] || g.types[name+"Params"] {
+			continue
+		}
+		if m.Returns == "" {
+			log.Printf("skipping %s: unknown return type", m.Name)
+			continue
+		}
+		if len(m.Params) > 0 {
+			g.writeParams(name, m)
+		}
+		g.writeMethod(name, m)
+	}
+}
+
+func (g *generator) writeParams(name string, m *methodDef) {
+	g.printf("// %sParams are the parameters of the %s method.\n", name, m.Name)
+	g.printf("type %sParams struct {\n", name)
+	for _, p := range m.Params {
+		help, tag := p.Help, p.Name
+		if !p.Required {
+			help, tag = "Optional. "+help, tag+",omitempty"
+		}
+		g.printf("\t// %s\n", help)
+		g.printf("\t%s %s `json:\"%s\"`\n", goFieldName(p.Name), g.fieldType(name+"Params", p), tag)
+	}
+	g.printf("}\n\n")
+	g.writeValidate(name, m)
+}
+
+func (g *generator) writeMethod(name string, m *methodDef) {
+	g.imports["context"] = true
+
+	// Signatures and arguments for the method and its Context variant.
+	params, ctxParams, args, in := "", "ctx context.Context", "context.Background()", "nil"
+	if len(m.Params) > 0 {
+		params = "p *" + name + "Params"
+		ctxParams += ", " + params
+		args += ", p"
+		in = "p"
+	}
+	results, zero := "error", ""
+	if m.Returns == "Message or True" {
+		results, zero = "(*Message, error)", "nil, "
+	} else if m.Returns != "True" && m.Returns != "Boolean" {
+		goType := g.goType(m.Returns)
+		results, zero = "("+goType+", error)", zeroValue(goType)+", "
+	}
+
+	g.writeDoc("", methodDoc(name, m))
+	g.printf("func (t *ApiClient) %s(%s) %s {\n", name, params, results)
+	g.printf("\treturn t.%sContext(%s)\n", name, args)
+	g.printf("}\n\n")
+
+	g.printf("// %sContext is like %s, but the request is bound to ctx.\n", name, name)
+	g.printf("func (t *ApiClient) %sContext(%s) %s {\n", name, ctxParams, results)
+	call := "t.CallContext(ctx, \"POST\", \"" + m.Name + "\", " + in + ", "
+	if g.hasUpload(m) {
+		call = "t.callUpload(ctx, \"" + m.Name + "\", p, "
+	}
+	u, array := g.decodable(m.Returns)
+	switch {
+	case results == "error":
+		g.printf("\tvar ok bool\n")
+		g.printf("\treturn %s&ok)\n", call)
+	case m.Returns == "Message or True":
+		g.imports["encoding/json"] = true
+		g.printf("\tvar result json.RawMessage\n")
+		g.printf("\tif err := %s&result); err != nil {\n", call)
+		g.printf("\t\treturn nil, err\n")
+		g.printf("\t}\n")
+		g.printf("\treturn editResult(result)\n")
+	case u != nil:
+		g.imports["encoding/json"] = true
+		fn, raw := "unmarshal"+u.def.Name, "json.RawMessage"
+		if array {
+			fn, raw = fn+"List", "[]json.RawMessage"
+		}
+		g.printf("\tvar result %s\n", raw)
+		g.printf("\tif err := %s&result); err != nil {\n", call)
+		g.printf("\t\treturn nil, err\n")
+		g.printf("\t}\n")
+		g.printf("\treturn %s(result)\n", fn)
+	case strings.HasPrefix(g.goType(m.Returns), "*"):
+		g.printf("\tout := new(%s)\n", strings.TrimPrefix(g.goType(m.Returns), "*"))
+		g.printf("\tif err := %sout); err != nil {\n", call)
+		g.printf("\t\treturn nil, err\n")
+		g.printf("\t}\n")
+		g.printf("\treturn out, nil\n")
+	default:
+		g.printf("\tvar out %s\n", g.goType(m.Returns))
+		g.printf("\tif err := %s&out); err != nil {\n", call)
+		g.printf("\t\treturn %serr\n", zero)
+		g.printf("\t}\n")
+		g.printf("\treturn out, nil\n")
+	}
+	g.printf("}\n\n")
+}
+
+// methodDoc returns the documentation of the method. Descriptions starting
+// with a verb, as in api.txt, follow the method name; the ones from the HTML
+// documentation follow a short summary.
+func methodDoc(name string, m *methodDef) string {
+	if m.Doc == "" {
+		return name + " calls the " + m.Name + " method."
+	}
+	if first := m.Doc[:1]; first == strings.ToLower(first) {
+		return name + " " + m.Doc
+	}
+	return name + " calls the " + m.Name + " method.\n\n" + m.Doc
+}
+
+// prepareUploads records the types that can hold uploads, with InputFile
+// fields of their own, of their variants or of other types they refer to.
+func (g *generator) prepareUploads(types []*typeDef) {
+	for changed := true; changed; {
+		changed = false
+		for _, t := range types {
+			if g.uploads[t.Name] {
+				continue
+			}
+			refs := t.Variants
+			for _, f := range t.Fields {
+				refs = append(refs, f.Type)
+			}
+			for _, ref := range refs {
+				if g.isUpload(ref) {
+					g.uploads[t.Name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// isUpload reports whether a value of the type ftype can be or hold an
+// upload.
+func (g *generator) isUpload(ftype string) bool {
+	ftype = strings.TrimPrefix(ftype, "Array of ")
+	return strings.Contains(ftype, "InputFile") || g.uploads[ftype]
+}
+
+// hasUpload reports whether any parameter of m can upload a file, requiring
+// a multipart/form-data request.
+func (g *generator) hasUpload(m *methodDef) bool {
+	for _, p := range m.Params {
+		if g.isUpload(p.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+func zeroValue(goType string) string {
+	switch {
+	case goType == "string":
+		return `""`
+	case goType == "int64" || goType == "float64":
+		return "0"
+	case goType == "bool":
+		return "false"
+	default:
+		return "nil"
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// scanPackage records the types and ApiClient methods declared by hand in
+// the Go files of dir, so that they are not generated again, and the fields
+// of the hand written params structs, to generate their Validate methods.
+// Generated files, named *.gen.go, and tests are ignored.
+func (g *generator) scanPackage(dir string) error {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, ".gen.go") && !strings.HasSuffix(name, "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				g.scanDecl(decl)
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) scanDecl(decl ast.Decl) {
+	switch d := decl.(type) {
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			g.types[ts.Name.Name] = true
+			switch t := ts.Type.(type) {
+			case *ast.InterfaceType:
+				g.interfaces[ts.Name.Name] = true
+			case *ast.StructType:
+				if strings.HasSuffix(ts.Name.Name, "Params") {
+					g.params[ts.Name.Name] = scanParams(t)
+				}
+			}
+		}
+	case *ast.FuncDecl:
+		if d.Recv == nil || len(d.Recv.List) != 1 {
+			return
+		}
+		recv := d.Recv.List[0].Type
+		if star, ok := recv.(*ast.StarExpr); ok {
+			recv = star.X
+		}
+		id, ok := recv.(*ast.Ident)
+		switch {
+		case !ok:
+		case id.Name == "ApiClient":
+			g.methods[d.Name.Name] = true
+		case d.Name.Name == "Validate":
+			g.validates[id.Name] = true
+		}
+	}
+}
+
+// scanParams returns the parameters of a params struct, described by the
+// field comments as in api.txt. Only strings, numbers and slices have an API
+// type, as other fields have no limits to check.
+func scanParams(t *ast.StructType) []*fieldDef {
+	var params []*fieldDef
+	for _, f := range t.Fields.List {
+		if len(f.Names) != 1 || f.Tag == nil || f.Doc == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		name := reflect.StructTag(tag).Get("json")
+		if name == "" || name == "-" {
+			continue
+		}
+		goType := types.ExprString(f.Type)
+		ftype := map[string]string{"string": "String", "int64": "Integer", "float64": "Float"}[strings.TrimPrefix(goType, "*")]
+		if strings.HasPrefix(goType, "[]") {
+			ftype = "Array of"
+		}
+		help := strings.TrimSpace(f.Doc.Text())
+		params = append(params, &fieldDef{
+			Name:     strings.Split(name, ",")[0],
+			Type:     ftype,
+			Required: !strings.HasSuffix(name, ",omitempty"),
+			Help:     strings.TrimPrefix(help, "Optional. "),
+			GoType:   goType,
+		})
+	}
+	return params
+}
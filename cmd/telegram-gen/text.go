@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// parseText parses the tab-separated api.txt description.
+//
+// Each type starts with a line with its name, followed by one line per
+// field with the name, type and description separated by tabs, and ends
+// with a blank line. Union types have instead a single line with the name
+// and the variants separated by " or ", such as "ChatMember\tChatMemberOwner
+// or ChatMemberMember".
+//
+// Methods start with a line with the method name, the return type and an
+// optional description that follows the Go method name in its doc comment,
+// such as "sends a dice.". It is followed by one line per parameter with the
+// name, type, either Yes or Optional, and the description.
+//
+// Other lines are ignored.
+func parseText(in io.Reader) (*spec, error) {
+	s := new(spec)
+	var t *typeDef
+	var m *methodDef
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := scanner.Text()
+		parts := strings.Split(line, "\t")
+		switch {
+		case len(parts) == 1 && strings.TrimSpace(line) == "":
+			t, m = nil, nil
+		case t == nil && m == nil && parts[0] != "" && unicode.IsLower([]rune(parts[0])[0]) && len(parts) >= 2:
+			m = &methodDef{Name: parts[0], Returns: parts[1]}
+			if len(parts) > 2 {
+				m.Doc = parts[2]
+			}
+			s.Methods = append(s.Methods, m)
+		case t == nil && m == nil && len(parts) == 2:
+			t = &typeDef{Name: parts[0], Variants: strings.Split(parts[1], " or ")}
+			s.Types = append(s.Types, t)
+		case len(parts) == 1:
+			t = &typeDef{Name: line}
+			s.Types = append(s.Types, t)
+		case len(parts) == 3 && t != nil:
+			t.Fields = append(t.Fields, &fieldDef{Name: parts[0], Type: parts[1], Required: !isOptional(parts[2]), Help: parts[2]})
+		case len(parts) == 4 && m != nil:
+			m.Params = append(m.Params, &fieldDef{Name: parts[0], Type: parts[1], Required: parts[2] == "Yes", Help: parts[3]})
+		}
+	}
+	return s, scanner.Err()
+}
@@ -0,0 +1,365 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// union is a type that can be one of several variants. When the variants
+// have a discriminator field, such as type or status, it is used to decode
+// the JSON into the right variant.
+type union struct {
+	def *typeDef
+	// field is the discriminator field name, and values have its value for
+	// each variant.
+	field  string
+	values map[string]string
+	// keys have the required field that only the variant has, for the
+	// variants that share a discriminator value, as the cached and not cached
+	// inline query results, or all of them if there is no discriminator.
+	// The variant without a key is decoded when no key is present.
+	keys map[string]string
+}
+
+// unionFallback maps unions without a discriminator to the variant used to
+// decode them. Inaccessible messages are decoded as a Message with Date 0.
+var unionFallback = map[string]string{
+	"MaybeInaccessibleMessage": "Message",
+}
+
+var reDiscriminator = regexp.MustCompile(`(?:always|must be) “?([a-z0-9_]+)”?\.?$`)
+
+// prepareUnions records the union types of s that are not declared by hand,
+// finding how their variants are decoded.
+func (g *generator) prepareUnions(s *spec) {
+	types := make(map[string]*typeDef, len(s.Types))
+	for _, t := range s.Types {
+		types[t.Name] = t
+	}
+	for _, t := range s.Types {
+		if len(t.Variants) == 0 || g.types[t.Name] {
+			continue
+		}
+		u := &union{def: t, values: make(map[string]string), keys: make(map[string]string)}
+		var defs []*typeDef
+		for _, name := range t.Variants {
+			v, ok := types[name]
+			if !ok {
+				defs = nil
+				break
+			}
+			defs = append(defs, v)
+		}
+		if defs != nil {
+			u.resolve(defs)
+		}
+		g.unions[t.Name] = u
+		for _, name := range t.Variants {
+			g.variants[name] = append(g.variants[name], u)
+		}
+	}
+}
+
+// resolve finds the discriminator field of the variants defs, and the keys
+// of the variants sharing the same value. Without a common discriminator,
+// all variants must be told apart by their keys. The union is left
+// undecodable if the variants can't be told apart.
+func (u *union) resolve(defs []*typeDef) {
+	groups := make(map[string][]*typeDef)
+	for _, v := range defs {
+		field, value := discriminator(v)
+		if field == "" || (u.field != "" && field != u.field) {
+			u.field, u.values = "", make(map[string]string)
+			groups = map[string][]*typeDef{"": defs}
+			break
+		}
+		u.field, u.values[v.Name] = field, value
+		groups[value] = append(groups[value], v)
+	}
+	for value, group := range groups {
+		if len(group) == 1 && value != "" {
+			continue
+		}
+		keys, ok := variantKeys(group)
+		if !ok {
+			u.field, u.keys = "", make(map[string]string)
+			return
+		}
+		for name, key := range keys {
+			u.keys[name] = key
+		}
+	}
+}
+
+// variantKeys returns, for the variants in group, the first required field
+// that the others in the group don't have. The variant without such field,
+// or else the last one, has no key, and is the default. It reports false if
+// more than one variant has no key.
+func variantKeys(group []*typeDef) (map[string]string, bool) {
+	keys := make(map[string]string)
+	var defaults int
+	for _, v := range group {
+		for _, f := range v.Fields {
+			if f.Required && !otherHas(group, v, f.Name) {
+				keys[v.Name] = f.Name
+				break
+			}
+		}
+		if keys[v.Name] == "" {
+			defaults++
+		}
+	}
+	switch defaults {
+	case 0:
+		delete(keys, group[len(group)-1].Name)
+	case 1:
+	default:
+		return nil, false
+	}
+	return keys, true
+}
+
+// otherHas reports whether a variant in group other than v has the field
+// named name.
+func otherHas(group []*typeDef, v *typeDef, name string) bool {
+	for _, o := range group {
+		if o == v {
+			continue
+		}
+		for _, f := range o.Fields {
+			if f.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// discriminator returns the field of t that always has the same value, and
+// that value.
+func discriminator(t *typeDef) (string, string) {
+	for _, f := range t.Fields {
+		if f.Type != "String" {
+			continue
+		}
+		if m := reDiscriminator.FindStringSubmatch(f.Help); m != nil {
+			return f.Name, m[1]
+		}
+	}
+	return "", ""
+}
+
+// decodable reports whether the variants of u can be told apart.
+func (u *union) decodable() bool {
+	return u.field != "" || len(u.keys) > 0
+}
+
+// decodable returns the union that can be decoded for the field type ftype,
+// either directly or as an array, and whether it is an array.
+func (g *generator) decodable(ftype string) (*union, bool) {
+	array := strings.HasPrefix(ftype, "Array of ")
+	u := g.unions[strings.TrimPrefix(ftype, "Array of ")]
+	if u == nil || !u.decodable() {
+		return nil, false
+	}
+	return u, array
+}
+
+// writeUnion writes the interface of the union, implemented by its
+// variants, and the function that decodes it.
+func (g *generator) writeUnion(u *union) {
+	t := u.def
+	marker := unexported(t.Name)
+	g.printf("type %s interface {\n", t.Name)
+	g.printf("\t%s()\n", marker)
+	g.printf("}\n\n")
+	for _, v := range t.Variants {
+		g.printf("func (*%s) %s() {}\n", v, marker)
+	}
+	g.printf("\n")
+	if !u.decodable() {
+		return
+	}
+
+	g.imports["encoding/json"] = true
+	unknown, field := "Unknown"+t.Name, goFieldName(u.field)
+	if u.field != "" {
+		g.printf("// %s is a %s variant not known by this package, such as\n", unknown, t.Name)
+		g.printf("// the ones added by newer API versions.\n")
+		g.printf("type %s struct {\n", unknown)
+		g.printf("\t// %s is the %s of the value.\n", field, u.field)
+		g.printf("\t%s string\n", field)
+		g.printf("\t// Raw is the JSON encoding of the value.\n")
+		g.printf("\tRaw json.RawMessage\n")
+		g.printf("}\n\n")
+		g.printf("func (*%s) %s() {}\n\n", unknown, marker)
+		g.printf("// MarshalJSON returns the JSON encoding of the value.\n")
+		g.printf("func (v *%s) MarshalJSON() ([]byte, error) {\n", unknown)
+		g.printf("\tif len(v.Raw) == 0 {\n")
+		g.printf("\t\treturn []byte(\"null\"), nil\n")
+		g.printf("\t}\n")
+		g.printf("\treturn v.Raw, nil\n")
+		g.printf("}\n\n")
+
+		g.printf("// unmarshal%s decodes b into the %s variant named by its %s field.\n", t.Name, t.Name, u.field)
+		g.printf("// Unknown variants, from newer API versions, are decoded as *%s.\n", unknown)
+	} else {
+		g.printf("// unmarshal%s decodes b into the %s variant with its fields.\n", t.Name, t.Name)
+	}
+	g.printf("func unmarshal%s(b json.RawMessage) (%s, error) {\n", t.Name, t.Name)
+	g.printf("\tif len(b) == 0 || string(b) == \"null\" {\n")
+	g.printf("\t\treturn nil, nil\n")
+	g.printf("\t}\n")
+	g.printf("\tvar d struct {\n")
+	if u.field != "" {
+		g.printf("\t\tValue string `json:\"%s\"`\n", u.field)
+	}
+	keys := make(map[string]bool)
+	for _, name := range t.Variants {
+		if key := u.keys[name]; key != "" && !keys[key] {
+			keys[key] = true
+			g.printf("\t\t%s json.RawMessage `json:\"%s\"`\n", goFieldName(key), key)
+		}
+	}
+	g.printf("\t}\n")
+	g.printf("\tif err := json.Unmarshal(b, &d); err != nil {\n")
+	g.printf("\t\treturn nil, err\n")
+	g.printf("\t}\n")
+	g.printf("\tvar v %s\n", t.Name)
+	if u.field != "" {
+		g.printf("\tswitch d.Value {\n")
+		seen := make(map[string]bool)
+		for _, name := range t.Variants {
+			value := u.values[name]
+			if seen[value] {
+				continue
+			}
+			seen[value] = true
+			g.printf("\tcase %q:\n", value)
+			var group []string
+			for _, other := range t.Variants {
+				if u.values[other] == value {
+					group = append(group, other)
+				}
+			}
+			g.writeVariantSwitch(u, group, "\t\t")
+		}
+		g.printf("\tdefault:\n")
+		g.printf("\t\treturn &%s{%s: d.Value, Raw: append(json.RawMessage(nil), b...)}, nil\n", unknown, field)
+		g.printf("\t}\n")
+	} else {
+		g.writeVariantSwitch(u, t.Variants, "\t")
+	}
+	g.printf("\tif err := json.Unmarshal(b, v); err != nil {\n")
+	g.printf("\t\treturn nil, err\n")
+	g.printf("\t}\n")
+	g.printf("\treturn v, nil\n")
+	g.printf("}\n\n")
+
+	g.printf("// unmarshal%sList decodes each item of bs with unmarshal%s.\n", t.Name, t.Name)
+	g.printf("func unmarshal%sList(bs []json.RawMessage) ([]%s, error) {\n", t.Name, t.Name)
+	g.printf("\tvar list []%s\n", t.Name)
+	g.printf("\tfor _, b := range bs {\n")
+	g.printf("\t\tv, err := unmarshal%s(b)\n", t.Name)
+	g.printf("\t\tif err != nil {\n")
+	g.printf("\t\t\treturn nil, err\n")
+	g.printf("\t\t}\n")
+	g.printf("\t\tlist = append(list, v)\n")
+	g.printf("\t}\n")
+	g.printf("\treturn list, nil\n")
+	g.printf("}\n\n")
+}
+
+// writeVariantSwitch writes the code that sets v to the variant in group
+// with its key present in d, or to the one without a key.
+func (g *generator) writeVariantSwitch(u *union, group []string, indent string) {
+	if len(group) == 1 {
+		g.printf("%sv = new(%s)\n", indent, group[0])
+		return
+	}
+	g.printf("%sswitch {\n", indent)
+	var def string
+	for _, name := range group {
+		key := u.keys[name]
+		if key == "" {
+			def = name
+			continue
+		}
+		g.printf("%scase d.%s != nil:\n", indent, goFieldName(key))
+		g.printf("%s\tv = new(%s)\n", indent, name)
+	}
+	g.printf("%sdefault:\n", indent)
+	g.printf("%s\tv = new(%s)\n", indent, def)
+	g.printf("%s}\n", indent)
+}
+
+// writeVariantMarshal writes the MarshalJSON method of a union variant, that
+// sets the discriminator field.
+func (g *generator) writeVariantMarshal(t *typeDef) {
+	for _, u := range g.variants[t.Name] {
+		if u.field == "" {
+			continue
+		}
+		g.imports["encoding/json"] = true
+		g.printf("// MarshalJSON encodes v with %s set to %q.\n", u.field, u.values[t.Name])
+		g.printf("func (v *%s) MarshalJSON() ([]byte, error) {\n", t.Name)
+		g.printf("\ttype alias %s\n", t.Name)
+		g.printf("\ta := alias(*v)\n")
+		g.printf("\ta.%s = %q\n", goFieldName(u.field), u.values[t.Name])
+		g.printf("\treturn json.Marshal(&a)\n")
+		g.printf("}\n\n")
+		return
+	}
+}
+
+// writeUnmarshal writes the UnmarshalJSON method of a struct with union
+// fields, decoding them into their variants.
+func (g *generator) writeUnmarshal(t *typeDef) {
+	var fields []*fieldDef
+	for _, f := range t.Fields {
+		if u, _ := g.decodable(f.Type); u != nil {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+	g.imports["encoding/json"] = true
+	g.printf("// UnmarshalJSON decodes v, including the variants of its union fields.\n")
+	g.printf("func (v *%s) UnmarshalJSON(b []byte) error {\n", t.Name)
+	g.printf("\ttype alias %s\n", t.Name)
+	g.printf("\taux := struct {\n")
+	g.printf("\t\t*alias\n")
+	for _, f := range fields {
+		raw := "json.RawMessage"
+		if _, array := g.decodable(f.Type); array {
+			raw = "[]json.RawMessage"
+		}
+		g.printf("\t\t%s %s `json:\"%s\"`\n", goFieldName(f.Name), raw, f.Name)
+	}
+	g.printf("\t}{alias: (*alias)(v)}\n")
+	g.printf("\tif err := json.Unmarshal(b, &aux); err != nil {\n")
+	g.printf("\t\treturn err\n")
+	g.printf("\t}\n")
+	g.printf("\tvar err error\n")
+	for _, f := range fields {
+		u, array := g.decodable(f.Type)
+		fn := "unmarshal" + u.def.Name
+		if array {
+			fn += "List"
+		}
+		name := goFieldName(f.Name)
+		g.printf("\tif v.%s, err = %s(aux.%s); err != nil {\n", name, fn, name)
+		g.printf("\t\treturn err\n")
+		g.printf("\t}\n")
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+}
+
+func unexported(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
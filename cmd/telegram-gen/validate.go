@@ -0,0 +1,148 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// constraint is a limit of a parameter, checked by the validator method
+// named check, such as "chars" or "intRange".
+type constraint struct {
+	check    string
+	min, max string
+}
+
+// formatted is the check of texts whose length is counted after entities
+// parsing, and reports whether they have formatting markup.
+const formatted = "formatted"
+
+var (
+	reLength = regexp.MustCompile(`\b(\d+)-(\d+) (characters|bytes)\b`)
+	reUpTo   = regexp.MustCompile(`\bup to (\d+) (characters|bytes)\b`)
+	reRange  = regexp.MustCompile(`(?:[Bb]etween |; )(\d+)(?: and |-)(\d+)\b(,? or)?`)
+	reItems  = regexp.MustCompile(`\b[Aa]t most (\d+) \w+ can be specified`)
+)
+
+// paramConstraint returns the limit described in the help of p, as in "0-4096
+// characters", "up to 512 characters", "between 1 and 360" or "At most 100
+// commands can be specified", or nil if there is none.
+func paramConstraint(p *fieldDef) *constraint {
+	switch {
+	case p.Type == "String":
+		if m := reLength.FindStringSubmatch(p.Help); m != nil {
+			c := &constraint{check: unit(m[3]), min: m[1], max: m[2]}
+			if c.check == "chars" && strings.Contains(p.Help, "after entities parsing") {
+				c.check = formatted
+			}
+			return c
+		}
+		if m := reUpTo.FindStringSubmatch(p.Help); m != nil {
+			return &constraint{check: unit(m[2]), min: "0", max: m[1]}
+		}
+	case p.Type == "Integer" || p.Type == "Float":
+		// Ranges with exceptions, as in "between 60 and 86400, or
+		// 0x7FFFFFFF", are not checked.
+		if m := reRange.FindStringSubmatch(p.Help); m != nil && m[3] == "" {
+			check := "intRange"
+			if p.Type == "Float" {
+				check = "floatRange"
+			}
+			return &constraint{check: check, min: m[1], max: m[2]}
+		}
+	case strings.HasPrefix(p.Type, "Array of"):
+		if m := reItems.FindStringSubmatch(p.Help); m != nil {
+			return &constraint{check: "items", max: m[1]}
+		}
+	}
+	return nil
+}
+
+func unit(u string) string {
+	if u == "bytes" {
+		return "bytes"
+	}
+	return "chars"
+}
+
+// markup returns the expression that reports whether the text parameter p
+// has formatting markup, with the parse mode set in params, such as
+// caption_parse_mode or parse_mode for a caption. Entities don't change the
+// length of the text, so they are not markup.
+func markup(p *fieldDef, params []*fieldDef) string {
+	names := map[string]bool{}
+	for _, q := range params {
+		names[q.Name] = true
+	}
+	for _, mode := range []string{p.Name + "_parse_mode", "parse_mode"} {
+		if names[mode] {
+			return "p." + goFieldName(mode) + " != \"\""
+		}
+	}
+	return "false"
+}
+
+// writeParamsValidate writes the Validate methods of the params structs
+// declared by hand, using the limits in their field comments.
+func (g *generator) writeParamsValidate() {
+	var names []string
+	for name := range g.params {
+		if !g.validates[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		name = strings.TrimSuffix(name, "Params")
+		method := strings.ToLower(name[:1]) + name[1:]
+		g.writeValidate(name, &methodDef{Name: method, Params: g.params[name+"Params"]})
+	}
+}
+
+// writeValidate writes the Validate method of the params struct of m, if any
+// of its parameters has a documented limit.
+func (g *generator) writeValidate(name string, m *methodDef) {
+	var checks []string
+	for _, p := range m.Params {
+		c := paramConstraint(p)
+		if c == nil {
+			continue
+		}
+		field, value := "p."+goFieldName(p.Name), "p."+goFieldName(p.Name)
+		pointer := strings.HasPrefix(g.fieldType(name+"Params", p), "*")
+		if pointer {
+			value = "*" + field
+		}
+		var check string
+		switch c.check {
+		case "items":
+			check = "v.items(\"" + p.Name + "\", len(" + field + "), " + c.max + ")"
+		case formatted:
+			check = "v.formatted(\"" + p.Name + "\", " + value + ", " + c.min + ", " + c.max + ", " + markup(p, m.Params) + ")"
+		default:
+			check = "v." + c.check + "(\"" + p.Name + "\", " + value + ", " + c.min + ", " + c.max + ")"
+		}
+		// Optional parameters are checked only when set.
+		switch {
+		case pointer:
+			check = "if " + field + " != nil {\n\t\t" + check + "\n\t}"
+		case p.Required:
+		case c.check == "intRange" || c.check == "floatRange":
+			check = "if " + field + " != 0 {\n\t\t" + check + "\n\t}"
+		case (c.check == "chars" || c.check == "bytes" || c.check == formatted) && c.min != "0":
+			check = "if " + field + " != \"\" {\n\t\t" + check + "\n\t}"
+		}
+		checks = append(checks, check)
+	}
+	if len(checks) == 0 {
+		return
+	}
+	g.printf("// Validate checks the parameters against the limits of the %s method.\n", m.Name)
+	g.printf("func (p *%sParams) Validate() error {\n", name)
+	g.printf("\tvar v validator\n")
+	for _, check := range checks {
+		g.printf("\t%s\n", check)
+	}
+	g.printf("\treturn v.err()\n")
+	g.printf("}\n\n")
+}
@@ -6,3 +6,30 @@ const (
 	ParseModeHTML     ParseMode = "HTML"
 	ParseModeMarkdown ParseMode = "Markdown"
 )
+
+// Bool returns a pointer to b, to set optional Boolean fields, which are
+// omitted when nil.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Int64 returns a pointer to i, to set optional Integer fields, which are
+// omitted when nil.
+func Int64(i int64) *int64 {
+	return &i
+}
+
+// Float64 returns a pointer to f, to set optional Float fields, which are
+// omitted when nil.
+func Float64(f float64) *float64 {
+	return &f
+}
+
+// int64Value returns the value of an optional Integer field, or 0 if it is
+// not set.
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
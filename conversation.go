@@ -0,0 +1,292 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndConversation is the state name returned by a StateFunc to finish the
+// conversation.
+const EndConversation = ""
+
+// ConversationKey identifies a conversation with a user in a chat.
+type ConversationKey struct {
+	ChatId int64
+	UserId int64
+}
+
+// ConversationKeyFor returns the conversation key for the chat and sender of
+// u. It returns false for updates that do not happen in a chat, such as
+// inline queries.
+func ConversationKeyFor(u *Update) (ConversationKey, bool) {
+	chat, user := u.Chat(), u.Sender()
+	if chat == nil || user == nil {
+		return ConversationKey{}, false
+	}
+	return ConversationKey{ChatId: chat.Id, UserId: user.Id}, true
+}
+
+// ConversationState is the stored state of an ongoing conversation.
+type ConversationState struct {
+	// Name is the current state name.
+	Name string `json:"name"`
+	// Data holds the values collected during the conversation.
+	Data map[string]string `json:"data,omitempty"`
+	// UpdatedAt is when the state was last changed.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ConversationStorage persists the state of conversations.
+// Implementations must be safe for concurrent use.
+type ConversationStorage interface {
+	// Get returns the state for key, or nil if there is no conversation.
+	Get(ctx context.Context, key ConversationKey) (*ConversationState, error)
+	// Set saves the state for key.
+	Set(ctx context.Context, key ConversationKey, s *ConversationState) error
+	// Delete removes the state for key.
+	Delete(ctx context.Context, key ConversationKey) error
+}
+
+// MemoryStorage is a ConversationStorage that keeps states in memory.
+type MemoryStorage struct {
+	mu     sync.Mutex
+	states map[ConversationKey]*ConversationState
+}
+
+// NewMemoryStorage returns an empty MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		states: make(map[ConversationKey]*ConversationState),
+	}
+}
+
+// Get implements the ConversationStorage interface.
+func (m *MemoryStorage) Get(ctx context.Context, key ConversationKey) (*ConversationState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.states[key]
+	if !ok {
+		return nil, nil
+	}
+	return s.copy(), nil
+}
+
+// Set implements the ConversationStorage interface.
+func (m *MemoryStorage) Set(ctx context.Context, key ConversationKey, s *ConversationState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[key] = s.copy()
+	return nil
+}
+
+// Delete implements the ConversationStorage interface.
+func (m *MemoryStorage) Delete(ctx context.Context, key ConversationKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.states, key)
+	return nil
+}
+
+func (s *ConversationState) copy() *ConversationState {
+	c := *s
+	if s.Data != nil {
+		c.Data = make(map[string]string, len(s.Data))
+		for k, v := range s.Data {
+			c.Data[k] = v
+		}
+	}
+	return &c
+}
+
+// StateFunc handles an update received while a conversation is in a given
+// state. Changes made to s.Data are saved, and the returned name is the next
+// state of the conversation, or EndConversation to finish it.
+type StateFunc func(ctx context.Context, u *Update, s *ConversationState) string
+
+// Conversation is a Handler that drives multi-step flows, keeping the state
+// of each (chat, user) pair in a ConversationStorage.
+//
+// Conversations begin with a command registered with Entry or with a call to
+// Start. While a conversation is active, messages and callback queries from
+// the user in that chat are routed to the StateFunc of the current state.
+// Any other update goes to the default Handler.
+//
+// Commands addressed to other bots, such as /cancel@OtherBot in a group,
+// neither start nor cancel conversations, and go to the default Handler.
+type Conversation struct {
+	// BotUsername is the username of the bot, used to recognize commands
+	// addressed to it, such as /start@MyBot. If empty, only commands
+	// without a bot username are recognized.
+	BotUsername string
+	// Timeout ends conversations that had no activity for the duration.
+	// Zero means conversations never expire.
+	Timeout time.Duration
+	// TimeoutHandler, if not nil, receives the first update of an expired
+	// conversation before it is handled as a new one.
+	TimeoutHandler Handler
+	// CancelCommands are the commands that end an active conversation.
+	// Defaults to "cancel".
+	CancelCommands []string
+	// CancelHandler, if not nil, receives the cancel command update.
+	CancelHandler Handler
+	// ErrorHandler, if not nil, receives the errors from the storage.
+	// Defaults to logging them with the standard logger.
+	ErrorHandler func(ctx context.Context, u *Update, err error)
+
+	storage  ConversationStorage
+	entries  map[string]StateFunc
+	states   map[string]StateFunc
+	fallback Handler
+}
+
+// NewConversation returns a Conversation that saves states in storage.
+// If storage is nil, a MemoryStorage is used.
+func NewConversation(storage ConversationStorage) *Conversation {
+	if storage == nil {
+		storage = NewMemoryStorage()
+	}
+	return &Conversation{
+		CancelCommands: []string{"cancel"},
+		storage:        storage,
+		entries:        make(map[string]StateFunc),
+		states:         make(map[string]StateFunc),
+	}
+}
+
+// Entry registers f to start a conversation when command is received.
+// The state returned by f becomes the current state.
+func (c *Conversation) Entry(command string, f StateFunc) {
+	c.entries[strings.ToLower(strings.TrimPrefix(command, "/"))] = f
+}
+
+// State registers f to handle updates while the conversation is in the named
+// state.
+func (c *Conversation) State(name string, f StateFunc) {
+	c.states[name] = f
+}
+
+// Default registers the Handler for updates outside of a conversation.
+func (c *Conversation) Default(h Handler) {
+	c.fallback = h
+}
+
+// Start begins a conversation for key in the provided state, with optional
+// initial data. The next update from the user in the chat is passed to the
+// StateFunc of that state.
+func (c *Conversation) Start(ctx context.Context, key ConversationKey, state string, data map[string]string) error {
+	s := &ConversationState{Name: state, Data: data}
+	if s.Data == nil {
+		s.Data = make(map[string]string)
+	}
+	return c.save(ctx, key, s)
+}
+
+// End finishes the conversation for key, if any.
+func (c *Conversation) End(ctx context.Context, key ConversationKey) error {
+	return c.storage.Delete(ctx, key)
+}
+
+// HandleUpdate implements the Handler interface.
+func (c *Conversation) HandleUpdate(ctx context.Context, u *Update) {
+	key, ok := ConversationKeyFor(u)
+	if !ok || (u.Message == nil && u.CallbackQuery == nil) {
+		c.handleDefault(ctx, u)
+		return
+	}
+	cmd := ParseCommand(u.Message)
+	if cmd != nil && cmd.Bot != "" && !strings.EqualFold(cmd.Bot, strings.TrimPrefix(c.BotUsername, "@")) {
+		c.handleDefault(ctx, u)
+		return
+	}
+	s, err := c.storage.Get(ctx, key)
+	if err != nil {
+		c.handleError(ctx, u, err)
+		return
+	}
+	if s != nil && c.Timeout > 0 && time.Since(s.UpdatedAt) > c.Timeout {
+		c.delete(ctx, key, u)
+		s = nil
+		if c.TimeoutHandler != nil {
+			c.TimeoutHandler.HandleUpdate(ctx, u)
+		}
+	}
+
+	if s != nil {
+		if cmd != nil && c.isCancel(cmd.Name) {
+			c.delete(ctx, key, u)
+			if c.CancelHandler != nil {
+				c.CancelHandler.HandleUpdate(ctx, u)
+			}
+			return
+		}
+		f, ok := c.states[s.Name]
+		if !ok {
+			c.delete(ctx, key, u)
+			c.handleDefault(ctx, u)
+			return
+		}
+		c.next(ctx, key, u, s, f)
+		return
+	}
+
+	if cmd != nil {
+		if f, ok := c.entries[cmd.Name]; ok {
+			c.next(ctx, key, u, &ConversationState{Data: make(map[string]string)}, f)
+			return
+		}
+	}
+	c.handleDefault(ctx, u)
+}
+
+// next runs f and saves the resulting state.
+func (c *Conversation) next(ctx context.Context, key ConversationKey, u *Update, s *ConversationState, f StateFunc) {
+	if s.Data == nil {
+		s.Data = make(map[string]string)
+	}
+	s.Name = f(ctx, u, s)
+	if s.Name == EndConversation {
+		c.delete(ctx, key, u)
+		return
+	}
+	if err := c.save(ctx, key, s); err != nil {
+		c.handleError(ctx, u, err)
+	}
+}
+
+func (c *Conversation) save(ctx context.Context, key ConversationKey, s *ConversationState) error {
+	s.UpdatedAt = time.Now()
+	return c.storage.Set(ctx, key, s)
+}
+
+// delete removes the state for key, reporting any error.
+func (c *Conversation) delete(ctx context.Context, key ConversationKey, u *Update) {
+	if err := c.storage.Delete(ctx, key); err != nil {
+		c.handleError(ctx, u, err)
+	}
+}
+
+func (c *Conversation) handleError(ctx context.Context, u *Update, err error) {
+	if c.ErrorHandler != nil {
+		c.ErrorHandler(ctx, u, err)
+		return
+	}
+	stderrDebug(fmt.Sprintf("telegram: conversation storage error on update %d: %v", u.UpdateId, err))
+}
+
+func (c *Conversation) isCancel(name string) bool {
+	for _, cancel := range c.CancelCommands {
+		if strings.EqualFold(strings.TrimPrefix(cancel, "/"), name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Conversation) handleDefault(ctx context.Context, u *Update) {
+	if c.fallback != nil {
+		c.fallback.HandleUpdate(ctx, u)
+	}
+}
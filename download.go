@@ -0,0 +1,241 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// DefaultMaxDownloadSize is the maximum size of the files that bots can
+// download from the Telegram cloud Bot API server.
+const DefaultMaxDownloadSize = 20 << 20
+
+var (
+	// ErrFileTooLarge is returned when a file exceeds the maximum download
+	// size of the client.
+	ErrFileTooLarge = errors.New("telegram: file exceeds the maximum download size")
+	// ErrSizeMismatch is returned when the number of bytes downloaded
+	// differs from the size reported by Telegram.
+	ErrSizeMismatch = errors.New("telegram: downloaded size does not match the file size")
+
+	errNoFilePath = errors.New("telegram: file has no file_path, use GetFile to prepare it for download")
+)
+
+// SetMaxDownloadSize limits the size of downloaded files to n bytes. It
+// defaults to DefaultMaxDownloadSize, and a value of 0 disables the limit.
+func (t *ApiClient) SetMaxDownloadSize(n int64) {
+	t.maxDownload = n
+}
+
+// DownloadFile fetches the file from f.FilePath and writes the content into w.
+//
+// The download fails with ErrFileTooLarge if it exceeds the client maximum
+// download size, and with ErrSizeMismatch if the bytes written differ from
+// f.FileSize, when known.
+func (t *ApiClient) DownloadFile(f *File, w io.Writer) error {
+	return t.DownloadFileContext(context.Background(), f, w)
+}
+
+// DownloadFileContext is like DownloadFile, but the download is bound to ctx.
+func (t *ApiClient) DownloadFileContext(ctx context.Context, f *File, w io.Writer) error {
+	body, _, err := t.openDownload(ctx, f, 0)
+	if err != nil || body == nil {
+		return err
+	}
+	defer body.Close()
+	return t.copyDownload(f, w, body, 0)
+}
+
+// DownloadFileAt is like DownloadFile, but resumes a partial download that
+// has the first offset bytes already written into w. Only the missing bytes
+// are requested, using an HTTP Range request; if the server doesn't support
+// it, or returns a different range, the whole file is written again from the
+// start, truncating w first if it has a Truncate method, such as *os.File.
+func (t *ApiClient) DownloadFileAt(f *File, w io.WriterAt, offset int64) error {
+	return t.DownloadFileAtContext(context.Background(), f, w, offset)
+}
+
+// DownloadFileAtContext is like DownloadFileAt, but the download is bound to
+// ctx.
+func (t *ApiClient) DownloadFileAtContext(ctx context.Context, f *File, w io.WriterAt, offset int64) error {
+	body, start, err := t.openDownload(ctx, f, offset)
+	if err != nil || body == nil {
+		return err
+	}
+	defer body.Close()
+	if start == 0 && offset > 0 {
+		if tr, ok := w.(interface{ Truncate(int64) error }); ok {
+			if err := tr.Truncate(0); err != nil {
+				return err
+			}
+		}
+	}
+	return t.copyDownload(f, &offsetWriter{w: w, off: start}, body, start)
+}
+
+// DownloadToFile downloads the file into path. The content is written into a
+// temporary file in the same directory, that is renamed to path only after
+// the download completes, so path is never left with a partial file.
+func (t *ApiClient) DownloadToFile(f *File, path string) error {
+	return t.DownloadToFileContext(context.Background(), f, path)
+}
+
+// DownloadToFileContext is like DownloadToFile, but the download is bound to
+// ctx.
+func (t *ApiClient) DownloadToFileContext(ctx context.Context, f *File, path string) (err error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*.part")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if err = t.DownloadFileContext(ctx, f, tmp); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// DownloadByID prepares the file with GetFile and downloads it into w.
+func (t *ApiClient) DownloadByID(fileId string, w io.Writer) (*File, error) {
+	return t.DownloadByIDContext(context.Background(), fileId, w)
+}
+
+// DownloadByIDContext is like DownloadByID, but the requests are bound to ctx.
+func (t *ApiClient) DownloadByIDContext(ctx context.Context, fileId string, w io.Writer) (*File, error) {
+	f, err := t.GetFileContext(ctx, fileId)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.DownloadFileContext(ctx, f, w); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// openDownload requests the content of f starting at offset. It returns the
+// response body and the offset where it actually starts, or a nil body if
+// there is nothing left to download.
+func (t *ApiClient) openDownload(ctx context.Context, f *File, offset int64) (io.ReadCloser, int64, error) {
+	if f.FilePath == "" {
+		return nil, 0, errNoFilePath
+	}
+	size := int64Value(f.FileSize)
+	if t.maxDownload > 0 && size > t.maxDownload {
+		return nil, 0, ErrFileTooLarge
+	}
+	if offset > 0 && size > 0 && offset >= size {
+		return nil, 0, nil
+	}
+
+	// https://api.telegram.org/file/bot<token>/<file_path>
+	url := fmt.Sprintf("%s%s/%s", t.downloadEndpoint, t.token, f.FilePath)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req = req.WithContext(ctx)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	start := int64(0)
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		if first, ok := contentRangeStart(resp.Header.Get("Content-Range")); !ok || first != offset {
+			// Not the requested range, so download the whole file.
+			resp.Body.Close()
+			return t.openDownload(ctx, f, 0)
+		}
+		start = offset
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0 && size == 0:
+		// The partial download was already complete.
+		resp.Body.Close()
+		return nil, 0, nil
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		defer resp.Body.Close()
+		return nil, 0, downloadError(resp)
+	}
+	if t.maxDownload > 0 && resp.ContentLength > 0 && start+resp.ContentLength > t.maxDownload {
+		resp.Body.Close()
+		return nil, 0, ErrFileTooLarge
+	}
+	return resp.Body, start, nil
+}
+
+// contentRangeStart returns the first byte position of a Content-Range
+// header, as in "bytes 100-199/200".
+func contentRangeStart(h string) (int64, bool) {
+	var first, last int64
+	var total string
+	if _, err := fmt.Sscanf(h, "bytes %d-%d/%s", &first, &last, &total); err != nil {
+		return 0, false
+	}
+	return first, true
+}
+
+// copyDownload copies body into w, checking the size of the file, including
+// the start bytes already downloaded.
+func (t *ApiClient) copyDownload(f *File, w io.Writer, body io.Reader, start int64) error {
+	if t.maxDownload > 0 {
+		// Read one extra byte to detect files larger than the limit.
+		body = io.LimitReader(body, t.maxDownload-start+1)
+	}
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return err
+	}
+	size := start + n
+	if t.maxDownload > 0 && size > t.maxDownload {
+		return ErrFileTooLarge
+	}
+	if want := int64Value(f.FileSize); want > 0 && size != want {
+		return fmt.Errorf("%w: got %d bytes, want %d", ErrSizeMismatch, size, want)
+	}
+	return nil
+}
+
+// downloadError returns the APIError for a failed download response.
+func downloadError(resp *http.Response) error {
+	b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	apiResp := new(ApiResponse)
+	if err := json.Unmarshal(b, apiResp); err == nil && (apiResp.ErrorCode != 0 || apiResp.Description != "") {
+		return newAPIError(resp.StatusCode, apiResp)
+	}
+	return &APIError{
+		StatusCode:  resp.StatusCode,
+		ErrorCode:   resp.StatusCode,
+		Description: resp.Status,
+	}
+}
+
+// offsetWriter writes sequentially into an io.WriterAt, starting at off.
+type offsetWriter struct {
+	w   io.WriterAt
+	off int64
+}
+
+func (o *offsetWriter) Write(p []byte) (int, error) {
+	n, err := o.w.WriteAt(p, o.off)
+	o.off += int64(n)
+	return n, err
+}
@@ -0,0 +1,236 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// MaxDeleteMessages is the maximum number of messages deleted per request
+// by the deleteMessages method.
+const MaxDeleteMessages = 100
+
+var errEditTarget = errors.New("telegram: either chat_id and message_id or inline_message_id must be set")
+
+// EditMessageTextParams are the parameters of the editMessageText method.
+// Either ChatId and MessageId, or InlineMessageId must be set.
+type EditMessageTextParams struct {
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+	// New text of the message, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+	// Optional. Mode for parsing entities in the message text
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []*MessageEntity `json:"entities,omitempty"`
+	// Optional. Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Optional. An inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageCaptionParams are the parameters of the editMessageCaption
+// method. Either ChatId and MessageId, or InlineMessageId must be set.
+type EditMessageCaptionParams struct {
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+	// Optional. New caption of the message, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the message caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. An inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageReplyMarkupParams are the parameters of the
+// editMessageReplyMarkup method. Either ChatId and MessageId, or
+// InlineMessageId must be set.
+type EditMessageReplyMarkupParams struct {
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+	// Optional. An inline keyboard. Use nil to remove the keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageMediaParams are the parameters of the editMessageMedia method.
+// Either ChatId and MessageId, or InlineMessageId must be set.
+type EditMessageMediaParams struct {
+	// Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id,omitempty"`
+	// Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageId *int64 `json:"message_id,omitempty"`
+	// Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+	// The new media content of the message. New files can be uploaded.
+	Media InputMedia `json:"media"`
+	// Optional. An inline keyboard
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageText changes the text of a message.
+//
+// The edited Message is returned, or nil for inline messages. If the new
+// text and markup are the same as the current ones, the error matches
+// ErrMessageNotModified.
+func (t *ApiClient) EditMessageText(p *EditMessageTextParams) (*Message, error) {
+	return t.EditMessageTextContext(context.Background(), p)
+}
+
+// EditMessageTextContext is like EditMessageText, but the request is bound to
+// ctx.
+func (t *ApiClient) EditMessageTextContext(ctx context.Context, p *EditMessageTextParams) (*Message, error) {
+	if err := checkEditTarget(p.ChatId, int64Value(p.MessageId), p.InlineMessageId); err != nil {
+		return nil, err
+	}
+	return t.callEdit(ctx, "editMessageText", p)
+}
+
+// EditMessageCaption changes the caption of a message.
+//
+// The edited Message is returned, or nil for inline messages. If the new
+// caption and markup are the same as the current ones, the error matches
+// ErrMessageNotModified.
+func (t *ApiClient) EditMessageCaption(p *EditMessageCaptionParams) (*Message, error) {
+	return t.EditMessageCaptionContext(context.Background(), p)
+}
+
+// EditMessageCaptionContext is like EditMessageCaption, but the request is
+// bound to ctx.
+func (t *ApiClient) EditMessageCaptionContext(ctx context.Context, p *EditMessageCaptionParams) (*Message, error) {
+	if err := checkEditTarget(p.ChatId, int64Value(p.MessageId), p.InlineMessageId); err != nil {
+		return nil, err
+	}
+	return t.callEdit(ctx, "editMessageCaption", p)
+}
+
+// EditMessageReplyMarkup changes the inline keyboard of a message.
+//
+// The edited Message is returned, or nil for inline messages. If the new
+// markup is the same as the current one, the error matches
+// ErrMessageNotModified.
+func (t *ApiClient) EditMessageReplyMarkup(p *EditMessageReplyMarkupParams) (*Message, error) {
+	return t.EditMessageReplyMarkupContext(context.Background(), p)
+}
+
+// EditMessageReplyMarkupContext is like EditMessageReplyMarkup, but the
+// request is bound to ctx.
+func (t *ApiClient) EditMessageReplyMarkupContext(ctx context.Context, p *EditMessageReplyMarkupParams) (*Message, error) {
+	if err := checkEditTarget(p.ChatId, int64Value(p.MessageId), p.InlineMessageId); err != nil {
+		return nil, err
+	}
+	return t.callEdit(ctx, "editMessageReplyMarkup", p)
+}
+
+// EditMessageMedia changes the media of a message, uploading it if needed.
+//
+// The edited Message is returned, or nil for inline messages. If the new
+// media and markup are the same as the current ones, the error matches
+// ErrMessageNotModified.
+func (t *ApiClient) EditMessageMedia(p *EditMessageMediaParams) (*Message, error) {
+	return t.EditMessageMediaContext(context.Background(), p)
+}
+
+// EditMessageMediaContext is like EditMessageMedia, but the request is bound
+// to ctx.
+func (t *ApiClient) EditMessageMediaContext(ctx context.Context, p *EditMessageMediaParams) (*Message, error) {
+	if err := checkEditTarget(p.ChatId, int64Value(p.MessageId), p.InlineMessageId); err != nil {
+		return nil, err
+	}
+	var result json.RawMessage
+	if err := t.callUpload(ctx, "editMessageMedia", p, &result); err != nil {
+		return nil, err
+	}
+	return editResult(result)
+}
+
+// DeleteMessage deletes a message.
+func (t *ApiClient) DeleteMessage(chatId string, messageId int64) error {
+	return t.DeleteMessageContext(context.Background(), chatId, messageId)
+}
+
+// DeleteMessageContext is like DeleteMessage, but the request is bound to ctx.
+func (t *ApiClient) DeleteMessageContext(ctx context.Context, chatId string, messageId int64) error {
+	params := map[string]interface{}{
+		"chat_id":    chatId,
+		"message_id": messageId,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteMessage", params, &ok)
+}
+
+// DeleteMessages deletes multiple messages from a chat. Messages that can't
+// be found are skipped. The identifiers are sent in batches of up to
+// MaxDeleteMessages.
+func (t *ApiClient) DeleteMessages(chatId string, messageIds []int64) error {
+	return t.DeleteMessagesContext(context.Background(), chatId, messageIds)
+}
+
+// DeleteMessagesContext is like DeleteMessages, but the requests are bound to
+// ctx.
+func (t *ApiClient) DeleteMessagesContext(ctx context.Context, chatId string, messageIds []int64) error {
+	for len(messageIds) > 0 {
+		n := len(messageIds)
+		if n > MaxDeleteMessages {
+			n = MaxDeleteMessages
+		}
+		params := map[string]interface{}{
+			"chat_id":     chatId,
+			"message_ids": messageIds[:n],
+		}
+		var ok bool
+		if err := t.CallContext(ctx, "POST", "deleteMessages", params, &ok); err != nil {
+			return err
+		}
+		messageIds = messageIds[n:]
+	}
+	return nil
+}
+
+func checkEditTarget(chatId string, messageId int64, inlineMessageId string) error {
+	if inlineMessageId != "" {
+		if chatId != "" || messageId != 0 {
+			return errEditTarget
+		}
+		return nil
+	}
+	if chatId == "" || messageId == 0 {
+		return errEditTarget
+	}
+	return nil
+}
+
+func (t *ApiClient) callEdit(ctx context.Context, apiMethod string, params interface{}) (*Message, error) {
+	var result json.RawMessage
+	if err := t.CallContext(ctx, "POST", apiMethod, params, &result); err != nil {
+		return nil, err
+	}
+	return editResult(result)
+}
+
+// editResult decodes the result of an edit method, which is the edited
+// Message, or true for inline messages.
+func editResult(result json.RawMessage) (*Message, error) {
+	if bytes.Equal(bytes.TrimSpace(result), []byte("true")) {
+		return nil, nil
+	}
+	msg := new(Message)
+	if err := json.Unmarshal(result, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
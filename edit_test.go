@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInputMediaJSON(t *testing.T) {
+	in := []InputMedia{
+		&InputMediaPhoto{Media: FileID("p1"), Caption: "photo"},
+		&InputMediaDocument{Media: FileURL("https://example.com/a.pdf")},
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatal(err)
+	}
+	out, err := unmarshalInputMediaList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 2 {
+		t.Fatalf("got %s, want 2 media", b)
+	}
+	photo, ok := out[0].(*InputMediaPhoto)
+	if !ok || photo.Type != "photo" || photo.Media.FileId != "p1" || photo.Caption != "photo" {
+		t.Errorf("got %#v from %s, want the photo", out[0], b)
+	}
+	doc, ok := out[1].(*InputMediaDocument)
+	if !ok || doc.Type != "document" || doc.Media.Url != "https://example.com/a.pdf" {
+		t.Errorf("got %#v from %s, want the document", out[1], b)
+	}
+}
+
+func TestReactionTypeUnknown(t *testing.T) {
+	b := json.RawMessage(`{"type":"paid"}`)
+	v, err := unmarshalReactionType(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, ok := v.(*UnknownReactionType)
+	if !ok || u.Type != "paid" {
+		t.Fatalf("got %#v, want an UnknownReactionType", v)
+	}
+	if got, err := json.Marshal(u); err != nil || string(got) != string(b) {
+		t.Errorf("got %s, %v, want %s", got, err, b)
+	}
+}
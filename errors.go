@@ -0,0 +1,99 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrMessageNotModified matches, using errors.Is, the APIError returned when
+// editing a message with the exact same content and markup. It is usually
+// safe to ignore.
+var ErrMessageNotModified = errors.New("telegram: message is not modified")
+
+// APIError is returned when the Bot API reports that a request failed.
+//
+// Use errors.As to inspect it, or one of the Is* helpers to check for the
+// most common failure conditions.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// ErrorCode is the error code reported by Telegram.
+	ErrorCode int
+	// Description is the human-readable error description.
+	Description string
+	// RetryAfter is the time to wait before the request can be repeated,
+	// set when flood control was exceeded.
+	RetryAfter time.Duration
+	// MigrateToChatId is the new identifier of a group that was migrated
+	// to a supergroup.
+	MigrateToChatId int64
+}
+
+func newAPIError(statusCode int, resp *ApiResponse) *APIError {
+	e := &APIError{
+		StatusCode:  statusCode,
+		ErrorCode:   resp.ErrorCode,
+		Description: resp.Description,
+	}
+	if e.ErrorCode == 0 {
+		e.ErrorCode = statusCode
+	}
+	if e.Description == "" {
+		e.Description = resp.Message
+	}
+	if p := resp.Parameters; p != nil {
+		e.RetryAfter = time.Duration(int64Value(p.RetryAfter)) * time.Second
+		e.MigrateToChatId = int64Value(p.MigrateToChatId)
+	}
+	return e
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram: %d: %s", e.ErrorCode, e.Description)
+}
+
+// Is reports whether target is ErrMessageNotModified and e was caused by
+// editing a message without changes.
+func (e *APIError) Is(target error) bool {
+	return target == ErrMessageNotModified && e.ErrorCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(e.Description), "message is not modified")
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var e *APIError
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// IsForbidden reports whether err is an APIError caused by the bot lacking
+// access to the chat, such as when the user blocked the bot.
+func IsForbidden(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.ErrorCode == http.StatusForbidden
+}
+
+// IsTooManyRequests reports whether err is an APIError caused by exceeding
+// the flood control limits. The wait time is available in RetryAfter.
+func IsTooManyRequests(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.ErrorCode == http.StatusTooManyRequests
+}
+
+// IsChatMigrated reports whether err is an APIError caused by sending to a
+// group that was upgraded to a supergroup. The new chat identifier is
+// available in MigrateToChatId.
+func IsChatMigrated(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.MigrateToChatId != 0
+}
+
+// IsMessageNotModified reports whether err is an APIError caused by editing
+// a message with the exact same content and markup.
+func IsMessageNotModified(err error) bool {
+	return errors.Is(err, ErrMessageNotModified)
+}
@@ -0,0 +1,191 @@
+package telegram
+
+import (
+	"context"
+	"sort"
+)
+
+// MaxBatchMessages is the maximum number of messages forwarded or copied per
+// request by the forwardMessages and copyMessages methods.
+const MaxBatchMessages = 100
+
+// ForwardMessageParams are the parameters of the forwardMessage method.
+type ForwardMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
+	FromChatId string `json:"from_chat_id"`
+	// Message identifier in the chat specified in from_chat_id
+	MessageId int64 `json:"message_id"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the forwarded message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+}
+
+// ForwardMessagesParams are the parameters of the forwardMessages method.
+type ForwardMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original messages were sent (or channel username in the format @channelusername)
+	FromChatId string `json:"from_chat_id"`
+	// Identifiers of the messages in the chat from_chat_id to forward
+	MessageIds []int64 `json:"message_ids"`
+	// Optional. Sends the messages silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the forwarded messages from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+}
+
+// CopyMessageParams are the parameters of the copyMessage method.
+type CopyMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
+	FromChatId string `json:"from_chat_id"`
+	// Message identifier in the chat specified in from_chat_id
+	MessageId int64 `json:"message_id"`
+	// Optional. New caption for media, 0-1024 characters after entities parsing. If nil, the original caption is kept; use a pointer to an empty string to remove it.
+	Caption *string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the new caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the new caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// CopyMessagesParams are the parameters of the copyMessages method.
+type CopyMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original messages were sent (or channel username in the format @channelusername)
+	FromChatId string `json:"from_chat_id"`
+	// Identifiers of the messages in the chat from_chat_id to copy
+	MessageIds []int64 `json:"message_ids"`
+	// Optional. Sends the messages silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent messages from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. Pass True to copy the messages without their captions
+	RemoveCaption *bool `json:"remove_caption,omitempty"`
+}
+
+// ForwardMessage forwards a message of any kind.
+func (t *ApiClient) ForwardMessage(p *ForwardMessageParams) (*Message, error) {
+	return t.ForwardMessageContext(context.Background(), p)
+}
+
+// ForwardMessageContext is like ForwardMessage, but the request is bound to
+// ctx.
+func (t *ApiClient) ForwardMessageContext(ctx context.Context, p *ForwardMessageParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.CallContext(ctx, "POST", "forwardMessage", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ForwardMessages forwards multiple messages, keeping album grouping.
+//
+// The identifiers are sorted and deduplicated, as the API requires them in
+// increasing order, and sent in batches of up to MaxBatchMessages. Messages
+// that can't be found or forwarded are skipped, so the returned identifiers
+// may be fewer than the ones requested.
+func (t *ApiClient) ForwardMessages(p *ForwardMessagesParams) ([]*MessageId, error) {
+	return t.ForwardMessagesContext(context.Background(), p)
+}
+
+// ForwardMessagesContext is like ForwardMessages, but the requests are bound
+// to ctx.
+func (t *ApiClient) ForwardMessagesContext(ctx context.Context, p *ForwardMessagesParams) ([]*MessageId, error) {
+	var ids []*MessageId
+	for _, batch := range messageBatches(p.MessageIds) {
+		params := *p
+		params.MessageIds = batch
+		var result []*MessageId
+		if err := t.CallContext(ctx, "POST", "forwardMessages", &params, &result); err != nil {
+			return ids, err
+		}
+		ids = append(ids, result...)
+	}
+	return ids, nil
+}
+
+// CopyMessage copies a message of any kind, except service, paid media and
+// some special messages. The copy has no link to the original message.
+func (t *ApiClient) CopyMessage(p *CopyMessageParams) (*MessageId, error) {
+	return t.CopyMessageContext(context.Background(), p)
+}
+
+// CopyMessageContext is like CopyMessage, but the request is bound to ctx.
+func (t *ApiClient) CopyMessageContext(ctx context.Context, p *CopyMessageParams) (*MessageId, error) {
+	id := new(MessageId)
+	if err := t.CallContext(ctx, "POST", "copyMessage", p, id); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// CopyMessages copies multiple messages, keeping album grouping.
+//
+// The identifiers are sorted and deduplicated, as the API requires them in
+// increasing order, and sent in batches of up to MaxBatchMessages. Messages
+// that can't be found or copied are skipped, so the returned identifiers may
+// be fewer than the ones requested.
+func (t *ApiClient) CopyMessages(p *CopyMessagesParams) ([]*MessageId, error) {
+	return t.CopyMessagesContext(context.Background(), p)
+}
+
+// CopyMessagesContext is like CopyMessages, but the requests are bound to ctx.
+func (t *ApiClient) CopyMessagesContext(ctx context.Context, p *CopyMessagesParams) ([]*MessageId, error) {
+	var ids []*MessageId
+	for _, batch := range messageBatches(p.MessageIds) {
+		params := *p
+		params.MessageIds = batch
+		var result []*MessageId
+		if err := t.CallContext(ctx, "POST", "copyMessages", &params, &result); err != nil {
+			return ids, err
+		}
+		ids = append(ids, result...)
+	}
+	return ids, nil
+}
+
+// messageBatches sorts and deduplicates ids, splitting them in batches of up
+// to MaxBatchMessages.
+func messageBatches(ids []int64) [][]int64 {
+	sorted := make([]int64, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	unique := sorted[:0]
+	for i, id := range sorted {
+		if i == 0 || id != sorted[i-1] {
+			unique = append(unique, id)
+		}
+	}
+	var batches [][]int64
+	for len(unique) > 0 {
+		n := len(unique)
+		if n > MaxBatchMessages {
+			n = MaxBatchMessages
+		}
+		batches = append(batches, unique[:n])
+		unique = unique[n:]
+	}
+	return batches
+}
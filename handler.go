@@ -0,0 +1,78 @@
+package telegram
+
+import (
+	"context"
+)
+
+// Handler responds to an incoming Update, regardless of how it was received.
+type Handler interface {
+	HandleUpdate(ctx context.Context, u *Update)
+}
+
+// HandlerFunc allows the use of ordinary functions as an update Handler.
+type HandlerFunc func(ctx context.Context, u *Update)
+
+// HandleUpdate calls f(ctx, u).
+func (f HandlerFunc) HandleUpdate(ctx context.Context, u *Update) {
+	f(ctx, u)
+}
+
+// Kind returns the name of the field set in the update, such as "message" or
+// "callback_query", or an empty string if the update type is unknown.
+func (u *Update) Kind() string {
+	switch {
+	case u.Message != nil:
+		return "message"
+	case u.EditedMessage != nil:
+		return "edited_message"
+	case u.InlineQuery != nil:
+		return "inline_query"
+	case u.ChosenInlineResult != nil:
+		return "chosen_inline_result"
+	case u.CallbackQuery != nil:
+		return "callback_query"
+	case u.MyChatMember != nil:
+		return "my_chat_member"
+	case u.ChatMember != nil:
+		return "chat_member"
+	}
+	return ""
+}
+
+// Sender returns the user that originated the update, if any.
+func (u *Update) Sender() *User {
+	switch {
+	case u.Message != nil:
+		return u.Message.From
+	case u.EditedMessage != nil:
+		return u.EditedMessage.From
+	case u.InlineQuery != nil:
+		return u.InlineQuery.From
+	case u.ChosenInlineResult != nil:
+		return u.ChosenInlineResult.From
+	case u.CallbackQuery != nil:
+		return u.CallbackQuery.From
+	case u.MyChatMember != nil:
+		return u.MyChatMember.From
+	case u.ChatMember != nil:
+		return u.ChatMember.From
+	}
+	return nil
+}
+
+// Chat returns the chat where the update happened, if any.
+func (u *Update) Chat() *Chat {
+	switch {
+	case u.Message != nil:
+		return u.Message.Chat
+	case u.EditedMessage != nil:
+		return u.EditedMessage.Chat
+	case u.CallbackQuery != nil && u.CallbackQuery.Message != nil:
+		return u.CallbackQuery.Message.Chat
+	case u.MyChatMember != nil:
+		return u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return u.ChatMember.Chat
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MaxInlineQueryResults is the maximum number of results per answer.
+const MaxInlineQueryResults = 50
+
+// AnswerInlineQueryParams are the parameters of the answerInlineQuery method.
+type AnswerInlineQueryParams struct {
+	// Unique identifier for the answered query
+	InlineQueryId string `json:"inline_query_id"`
+	// An array of results for the inline query, up to 50
+	Results []InlineQueryResult `json:"results"`
+	// Optional. The maximum amount of time in seconds that the result of the inline query may be cached on the server. Defaults to 300.
+	CacheTime *int64 `json:"cache_time,omitempty"`
+	// Optional. Pass True if results may be cached on the server side only for the user that sent the query.
+	IsPersonal *bool `json:"is_personal,omitempty"`
+	// Optional. Pass the offset that a client should send in the next query with the same text to receive more results. Pass an empty string if there are no more results or if you don't support pagination. Offset length can't exceed 64 bytes.
+	NextOffset string `json:"next_offset,omitempty"`
+	// Optional. A JSON-serialized object describing a button to be shown above inline query results
+	Button *InlineQueryResultsButton `json:"button,omitempty"`
+}
+
+// Validate checks the number of results, that their identifiers are unique
+// and within the size limits, and the size of the next offset.
+func (p *AnswerInlineQueryParams) Validate() error {
+	var v validator
+	v.bytes("inline_query_id", p.InlineQueryId, 1, 0)
+	v.items("results", len(p.Results), MaxInlineQueryResults)
+	v.bytes("next_offset", p.NextOffset, 0, 64)
+	ids := make(map[string]bool, len(p.Results))
+	for i, r := range p.Results {
+		field := fmt.Sprintf("results[%d]", i)
+		if r == nil {
+			v.add(field, "must not be nil")
+			continue
+		}
+		if _, ok := r.(*UnknownInlineQueryResult); ok {
+			continue
+		}
+		id := inlineQueryResultId(r)
+		v.bytes(field+".id", id, 1, 64)
+		if id != "" && ids[id] {
+			v.add(field+".id", "must be unique, got %q again", id)
+		}
+		ids[id] = true
+	}
+	return v.err()
+}
+
+// inlineQueryResultId returns the identifier of r, or "" if it has none.
+func inlineQueryResultId(r InlineQueryResult) string {
+	v := reflect.ValueOf(r)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return ""
+	}
+	if f := v.Elem().FieldByName("Id"); f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// PaginateInlineResults returns the page of results that starts at offset,
+// as received in InlineQuery.Offset, and the next_offset to be sent with
+// it, which is empty on the last page.
+func PaginateInlineResults(results []InlineQueryResult, offset string, pageSize int) ([]InlineQueryResult, string) {
+	if pageSize <= 0 || pageSize > MaxInlineQueryResults {
+		pageSize = MaxInlineQueryResults
+	}
+	start, err := strconv.Atoi(offset)
+	if err != nil || start < 0 {
+		start = 0
+	}
+	if start >= len(results) {
+		return nil, ""
+	}
+	end := start + pageSize
+	if end >= len(results) {
+		return results[start:], ""
+	}
+	return results[start:end], strconv.Itoa(end)
+}
+
+// AnswerInlineQuery sends the results for an inline query. The params are
+// validated before sending.
+func (t *ApiClient) AnswerInlineQuery(p *AnswerInlineQueryParams) error {
+	return t.AnswerInlineQueryContext(context.Background(), p)
+}
+
+// AnswerInlineQueryContext is like AnswerInlineQuery, but the request is bound
+// to ctx.
+func (t *ApiClient) AnswerInlineQueryContext(ctx context.Context, p *AnswerInlineQueryParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "answerInlineQuery", p, &ok)
+}
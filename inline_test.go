@@ -0,0 +1,61 @@
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInlineQueryResultJSON(t *testing.T) {
+	in := []InlineQueryResult{
+		&InlineQueryResultArticle{Id: "1", Title: "a", InputMessageContent: &InputTextMessageContent{MessageText: "hi"}},
+		&InlineQueryResultPhoto{Id: "2", PhotoUrl: "https://example.com/a.jpg", ThumbnailUrl: "https://example.com/t.jpg"},
+		&InlineQueryResultCachedPhoto{Id: "3", PhotoFileId: "p3"},
+		&InlineQueryResultVenue{Id: "4", Title: "v", Address: "x", InputMessageContent: &InputLocationMessageContent{Latitude: 1, Longitude: 2}},
+	}
+	b, err := json.Marshal(&AnswerInlineQueryParams{InlineQueryId: "q", Results: in})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p struct {
+		Results []json.RawMessage `json:"results"`
+	}
+	if err := json.Unmarshal(b, &p); err != nil {
+		t.Fatal(err)
+	}
+	out, err := unmarshalInlineQueryResultList(p.Results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("got %s, want %d results", b, len(in))
+	}
+	if r, ok := out[0].(*InlineQueryResultArticle); !ok || r.Type != "article" {
+		t.Errorf("got %#v, want the article", out[0])
+	} else if c, ok := r.InputMessageContent.(*InputTextMessageContent); !ok || c.MessageText != "hi" {
+		t.Errorf("got %#v, want the text content", r.InputMessageContent)
+	}
+	if r, ok := out[1].(*InlineQueryResultPhoto); !ok || r.Type != "photo" || r.PhotoUrl == "" {
+		t.Errorf("got %#v, want the photo", out[1])
+	}
+	if r, ok := out[2].(*InlineQueryResultCachedPhoto); !ok || r.Type != "photo" || r.PhotoFileId != "p3" {
+		t.Errorf("got %#v, want the cached photo", out[2])
+	}
+	if r, ok := out[3].(*InlineQueryResultVenue); !ok {
+		t.Errorf("got %#v, want the venue", out[3])
+	} else if _, ok := r.InputMessageContent.(*InputLocationMessageContent); !ok {
+		t.Errorf("got %#v, want the location content", r.InputMessageContent)
+	}
+}
+
+func TestAnswerInlineQueryDuplicatedId(t *testing.T) {
+	p := &AnswerInlineQueryParams{
+		InlineQueryId: "q",
+		Results: []InlineQueryResult{
+			&InlineQueryResultArticle{Id: "1"},
+			&InlineQueryResultCachedSticker{Id: "1"},
+		},
+	}
+	if err := p.Validate(); err == nil {
+		t.Error("got no error, want a duplicated id error")
+	}
+}
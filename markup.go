@@ -0,0 +1,185 @@
+package telegram
+
+import (
+	"strconv"
+)
+
+// ReplyMarkup is the additional interface sent with a message: one of
+// InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove,
+// ReplyKeyboardHide or ForceReply.
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+func (*InlineKeyboardMarkup) replyMarkup() {}
+func (*ReplyKeyboardMarkup) replyMarkup()  {}
+func (*ReplyKeyboardRemove) replyMarkup()  {}
+func (*ReplyKeyboardHide) replyMarkup()    {}
+func (*ForceReply) replyMarkup()           {}
+
+// RemoveKeyboard returns a ReplyKeyboardRemove that removes the custom
+// keyboard.
+func RemoveKeyboard() *ReplyKeyboardRemove {
+	return &ReplyKeyboardRemove{RemoveKeyboard: true}
+}
+
+// ForceReplyMarkup returns a ForceReply that shows the reply interface to
+// the user.
+func ForceReplyMarkup() *ForceReply {
+	return &ForceReply{ForceReply: true}
+}
+
+// CallbackButton returns an inline button that sends data in a callback query.
+func CallbackButton(text, data string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, CallbackData: data}
+}
+
+// URLButton returns an inline button that opens url.
+func URLButton(text, url string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, Url: url}
+}
+
+// SwitchInlineButton returns an inline button that starts an inline query
+// with the bot in another chat.
+func SwitchInlineButton(text, query string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, SwitchInlineQuery: query}
+}
+
+// InlineKeyboardBuilder builds an InlineKeyboardMarkup row by row.
+type InlineKeyboardBuilder struct {
+	rows    [][]*InlineKeyboardButton
+	columns int
+}
+
+// NewInlineKeyboard returns an empty InlineKeyboardBuilder.
+func NewInlineKeyboard() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Columns sets the maximum number of buttons per row used by Add.
+func (b *InlineKeyboardBuilder) Columns(n int) *InlineKeyboardBuilder {
+	b.columns = n
+	return b
+}
+
+// Row adds a new row with the provided buttons.
+func (b *InlineKeyboardBuilder) Row(buttons ...*InlineKeyboardButton) *InlineKeyboardBuilder {
+	b.rows = append(b.rows, buttons)
+	return b
+}
+
+// Add appends the buttons to the last row, wrapping to new rows when it has
+// as many buttons as set with Columns. Without Columns, all buttons go to
+// the last row.
+func (b *InlineKeyboardBuilder) Add(buttons ...*InlineKeyboardButton) *InlineKeyboardBuilder {
+	for _, button := range buttons {
+		last := len(b.rows) - 1
+		if last < 0 || (b.columns > 0 && len(b.rows[last]) >= b.columns) {
+			b.rows = append(b.rows, nil)
+			last++
+		}
+		b.rows[last] = append(b.rows[last], button)
+	}
+	return b
+}
+
+// Pagination adds a row of navigation buttons for page, from 1 to pages.
+// The data function returns the callback data of the button for a page.
+//
+// The row has buttons to the first, previous, next and last pages when
+// they are available, and an indicator of the current page.
+func (b *InlineKeyboardBuilder) Pagination(page, pages int, data func(page int) string) *InlineKeyboardBuilder {
+	if pages <= 1 {
+		return b
+	}
+	var row []*InlineKeyboardButton
+	if page > 2 {
+		row = append(row, CallbackButton("« 1", data(1)))
+	}
+	if page > 1 {
+		row = append(row, CallbackButton("‹ "+strconv.Itoa(page-1), data(page-1)))
+	}
+	row = append(row, CallbackButton("· "+strconv.Itoa(page)+" ·", data(page)))
+	if page < pages {
+		row = append(row, CallbackButton(strconv.Itoa(page+1)+" ›", data(page+1)))
+	}
+	if page < pages-1 {
+		row = append(row, CallbackButton(strconv.Itoa(pages)+" »", data(pages)))
+	}
+	return b.Row(row...)
+}
+
+// Markup returns the built InlineKeyboardMarkup.
+func (b *InlineKeyboardBuilder) Markup() *InlineKeyboardMarkup {
+	return &InlineKeyboardMarkup{InlineKeyboard: b.rows}
+}
+
+// ReplyKeyboardBuilder builds a ReplyKeyboardMarkup row by row.
+type ReplyKeyboardBuilder struct {
+	markup  ReplyKeyboardMarkup
+	columns int
+}
+
+// NewReplyKeyboard returns an empty ReplyKeyboardBuilder.
+func NewReplyKeyboard() *ReplyKeyboardBuilder {
+	return &ReplyKeyboardBuilder{}
+}
+
+// Columns sets the maximum number of buttons per row used by Add.
+func (b *ReplyKeyboardBuilder) Columns(n int) *ReplyKeyboardBuilder {
+	b.columns = n
+	return b
+}
+
+// Row adds a new row with buttons for each of the texts.
+func (b *ReplyKeyboardBuilder) Row(texts ...string) *ReplyKeyboardBuilder {
+	row := make([]*KeyboardButton, 0, len(texts))
+	for _, text := range texts {
+		row = append(row, &KeyboardButton{Text: text})
+	}
+	return b.ButtonRow(row...)
+}
+
+// ButtonRow adds a new row with the provided buttons.
+func (b *ReplyKeyboardBuilder) ButtonRow(buttons ...*KeyboardButton) *ReplyKeyboardBuilder {
+	b.markup.Keyboard = append(b.markup.Keyboard, buttons)
+	return b
+}
+
+// Add appends buttons to the last row, wrapping to new rows when it has as
+// many buttons as set with Columns.
+func (b *ReplyKeyboardBuilder) Add(buttons ...*KeyboardButton) *ReplyKeyboardBuilder {
+	for _, button := range buttons {
+		last := len(b.markup.Keyboard) - 1
+		if last < 0 || (b.columns > 0 && len(b.markup.Keyboard[last]) >= b.columns) {
+			b.markup.Keyboard = append(b.markup.Keyboard, nil)
+			last++
+		}
+		b.markup.Keyboard[last] = append(b.markup.Keyboard[last], button)
+	}
+	return b
+}
+
+// Resize requests clients to resize the keyboard to fit the buttons.
+func (b *ReplyKeyboardBuilder) Resize() *ReplyKeyboardBuilder {
+	b.markup.ResizeKeyboard = Bool(true)
+	return b
+}
+
+// OneTime requests clients to hide the keyboard after it is used.
+func (b *ReplyKeyboardBuilder) OneTime() *ReplyKeyboardBuilder {
+	b.markup.OneTimeKeyboard = Bool(true)
+	return b
+}
+
+// Selective shows the keyboard only to the users targeted by the message.
+func (b *ReplyKeyboardBuilder) Selective() *ReplyKeyboardBuilder {
+	b.markup.Selective = Bool(true)
+	return b
+}
+
+// Markup returns the built ReplyKeyboardMarkup.
+func (b *ReplyKeyboardBuilder) Markup() *ReplyKeyboardMarkup {
+	m := b.markup
+	return &m
+}
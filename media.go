@@ -0,0 +1,343 @@
+package telegram
+
+import (
+	"context"
+)
+
+// SendPhotoParams are the parameters of the sendPhoto method.
+type SendPhotoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Photo to send. The photo must be at most 10 MB in size.
+	Photo *InputFile `json:"photo"`
+	// Optional. Photo caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Pass True if the media needs to be covered with a spoiler animation
+	HasSpoiler *bool `json:"has_spoiler,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendPhoto sends a photo, uploading it if needed.
+func (t *ApiClient) SendPhoto(p *SendPhotoParams) (*Message, error) {
+	return t.SendPhotoContext(context.Background(), p)
+}
+
+// SendPhotoContext is like SendPhoto, but the request is bound to ctx.
+func (t *ApiClient) SendPhotoContext(ctx context.Context, p *SendPhotoParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendPhoto", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendDocumentParams are the parameters of the sendDocument method.
+type SendDocumentParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// File to send
+	Document *InputFile `json:"document"`
+	// Optional. Thumbnail of the file sent, JPEG format, less than 200 kB in size, up to 320x320. Can only be uploaded as a new file.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Document caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Disables automatic server-side content type detection for files uploaded using multipart/form-data
+	DisableContentTypeDetection *bool `json:"disable_content_type_detection,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendDocument sends a document, uploading it if needed.
+func (t *ApiClient) SendDocument(p *SendDocumentParams) (*Message, error) {
+	return t.SendDocumentContext(context.Background(), p)
+}
+
+// SendDocumentContext is like SendDocument, but the request is bound to ctx.
+func (t *ApiClient) SendDocumentContext(ctx context.Context, p *SendDocumentParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendDocument", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendAudioParams are the parameters of the sendAudio method.
+type SendAudioParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Audio file to send, in the .MP3 or .M4A format
+	Audio *InputFile `json:"audio"`
+	// Optional. Audio caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Duration of the audio in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Performer
+	Performer string `json:"performer,omitempty"`
+	// Optional. Track name
+	Title string `json:"title,omitempty"`
+	// Optional. Thumbnail of the file sent, JPEG format, less than 200 kB in size, up to 320x320. Can only be uploaded as a new file.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendAudio sends an audio, uploading it if needed.
+func (t *ApiClient) SendAudio(p *SendAudioParams) (*Message, error) {
+	return t.SendAudioContext(context.Background(), p)
+}
+
+// SendAudioContext is like SendAudio, but the request is bound to ctx.
+func (t *ApiClient) SendAudioContext(ctx context.Context, p *SendAudioParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendAudio", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendVideoParams are the parameters of the sendVideo method.
+type SendVideoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Video to send, in the MPEG4 format
+	Video *InputFile `json:"video"`
+	// Optional. Duration of the video in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Video width
+	Width *int64 `json:"width,omitempty"`
+	// Optional. Video height
+	Height *int64 `json:"height,omitempty"`
+	// Optional. Thumbnail of the file sent, JPEG format, less than 200 kB in size, up to 320x320. Can only be uploaded as a new file.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Video caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Pass True if the media needs to be covered with a spoiler animation
+	HasSpoiler *bool `json:"has_spoiler,omitempty"`
+	// Optional. Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming *bool `json:"supports_streaming,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendVideo sends a video, uploading it if needed.
+func (t *ApiClient) SendVideo(p *SendVideoParams) (*Message, error) {
+	return t.SendVideoContext(context.Background(), p)
+}
+
+// SendVideoContext is like SendVideo, but the request is bound to ctx.
+func (t *ApiClient) SendVideoContext(ctx context.Context, p *SendVideoParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendVideo", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendAnimationParams are the parameters of the sendAnimation method.
+type SendAnimationParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Animation to send, a GIF or H.264/MPEG-4 AVC video without sound
+	Animation *InputFile `json:"animation"`
+	// Optional. Duration of the animation in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Animation width
+	Width *int64 `json:"width,omitempty"`
+	// Optional. Animation height
+	Height *int64 `json:"height,omitempty"`
+	// Optional. Thumbnail of the file sent, JPEG format, less than 200 kB in size, up to 320x320. Can only be uploaded as a new file.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Animation caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Pass True if the media needs to be covered with a spoiler animation
+	HasSpoiler *bool `json:"has_spoiler,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendAnimation sends an animation, uploading it if needed.
+func (t *ApiClient) SendAnimation(p *SendAnimationParams) (*Message, error) {
+	return t.SendAnimationContext(context.Background(), p)
+}
+
+// SendAnimationContext is like SendAnimation, but the request is bound to ctx.
+func (t *ApiClient) SendAnimationContext(ctx context.Context, p *SendAnimationParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendAnimation", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendVoiceParams are the parameters of the sendVoice method.
+type SendVoiceParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Audio file to send, in an .OGG file encoded with OPUS, or in .MP3 or .M4A format
+	Voice *InputFile `json:"voice"`
+	// Optional. Voice message caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Optional. Mode for parsing entities in the caption
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	// Optional. Duration of the voice message in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendVoice sends a voice, uploading it if needed.
+func (t *ApiClient) SendVoice(p *SendVoiceParams) (*Message, error) {
+	return t.SendVoiceContext(context.Background(), p)
+}
+
+// SendVoiceContext is like SendVoice, but the request is bound to ctx.
+func (t *ApiClient) SendVoiceContext(ctx context.Context, p *SendVoiceParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendVoice", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendVideoNoteParams are the parameters of the sendVideoNote method.
+type SendVideoNoteParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Video note to send. Sending video notes by a URL is currently unsupported
+	VideoNote *InputFile `json:"video_note"`
+	// Optional. Duration of the video note in seconds
+	Duration *int64 `json:"duration,omitempty"`
+	// Optional. Video width and height, i.e. diameter of the video message
+	Length *int64 `json:"length,omitempty"`
+	// Optional. Thumbnail of the file sent, JPEG format, less than 200 kB in size, up to 320x320. Can only be uploaded as a new file.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendVideoNote sends a video note, uploading it if needed.
+func (t *ApiClient) SendVideoNote(p *SendVideoNoteParams) (*Message, error) {
+	return t.SendVideoNoteContext(context.Background(), p)
+}
+
+// SendVideoNoteContext is like SendVideoNote, but the request is bound to ctx.
+func (t *ApiClient) SendVideoNoteContext(ctx context.Context, p *SendVideoNoteParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendVideoNote", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendStickerParams are the parameters of the sendSticker method.
+type SendStickerParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Sticker to send, a .WEBP static, .TGS animated or .WEBM video sticker
+	Sticker *InputFile `json:"sticker"`
+	// Optional. Emoji associated with the sticker; only for just uploaded stickers
+	Emoji string `json:"emoji,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendSticker sends a sticker, uploading it if needed.
+func (t *ApiClient) SendSticker(p *SendStickerParams) (*Message, error) {
+	return t.SendStickerContext(context.Background(), p)
+}
+
+// SendStickerContext is like SendSticker, but the request is bound to ctx.
+func (t *ApiClient) SendStickerContext(ctx context.Context, p *SendStickerParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.callUpload(ctx, "sendSticker", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,48 @@
+package telegram
+
+import (
+	"context"
+)
+
+// SendMessageParams are the parameters of the sendMessage method.
+type SendMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId string `json:"chat_id"`
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId *int64 `json:"message_thread_id,omitempty"`
+	// Text of the message to be sent, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+	// Optional. Mode for parsing entities in the message text
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	// Optional. A list of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []*MessageEntity `json:"entities,omitempty"`
+	// Optional. Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Optional. Disables link previews for links in this message. Superseded by LinkPreviewOptions.
+	DisableWebPagePreview *bool `json:"disable_web_page_preview,omitempty"`
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent *bool `json:"protect_content,omitempty"`
+	// Optional. If the message is a reply, ID of the original message. Superseded by ReplyParameters.
+	ReplyToMessageId *int64 `json:"reply_to_message_id,omitempty"`
+	// Optional. Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Optional. Additional interface options: an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// Send sends a text message using all the options supported by the
+// sendMessage method.
+func (t *ApiClient) Send(p *SendMessageParams) (*Message, error) {
+	return t.SendContext(context.Background(), p)
+}
+
+// SendContext is like Send, but the request is bound to ctx.
+func (t *ApiClient) SendContext(ctx context.Context, p *SendMessageParams) (*Message, error) {
+	msg := new(Message)
+	if err := t.CallContext(ctx, "POST", "sendMessage", p, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
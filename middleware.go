@@ -0,0 +1,151 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Middleware wraps a Handler to add behavior before or after it runs.
+type Middleware func(next Handler) Handler
+
+// Chain returns h wrapped by the provided middleware. The first middleware
+// is the outermost one, and sees the update first.
+func Chain(h Handler, mw ...Middleware) Handler {
+	for i := len(mw) - 1; i >= 0; i-- {
+		h = mw[i](h)
+	}
+	return h
+}
+
+// Logger logs every update received, and the time it took to handle it.
+// A nil logf logs to the standard logger.
+func Logger(logf DebugFunc) Middleware {
+	if logf == nil {
+		logf = stderrDebug
+	}
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, u *Update) {
+			start := time.Now()
+			next.HandleUpdate(ctx, u)
+			logf(formatUpdateLog(u, time.Since(start)))
+		})
+	}
+}
+
+func formatUpdateLog(u *Update, d time.Duration) string {
+	from := int64(0)
+	if s := u.Sender(); s != nil {
+		from = s.Id
+	}
+	return fmt.Sprintf("telegram: update %d (%s) from %d handled in %v", u.UpdateId, u.Kind(), from, d)
+}
+
+// Recoverer recovers from panics in the next handlers, logging the panic
+// value and stack trace, so that a single update can't crash the bot. A nil
+// logf logs to the standard logger.
+func Recoverer(logf DebugFunc) Middleware {
+	if logf == nil {
+		logf = stderrDebug
+	}
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, u *Update) {
+			defer func() {
+				if r := recover(); r != nil {
+					logf(fmt.Sprintf("telegram: panic handling update %d: %v\n%s", u.UpdateId, r, debug.Stack()))
+				}
+			}()
+			next.HandleUpdate(ctx, u)
+		})
+	}
+}
+
+// AdminOnly only lets updates sent by the provided user identifiers reach
+// the next handlers. Other updates are dropped.
+func AdminOnly(admins ...int64) Middleware {
+	allowed := make(map[int64]bool, len(admins))
+	for _, id := range admins {
+		allowed[id] = true
+	}
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, u *Update) {
+			if s := u.Sender(); s != nil && allowed[s.Id] {
+				next.HandleUpdate(ctx, u)
+			}
+		})
+	}
+}
+
+// UserRateLimit limits how many updates each user can send to the next
+// handlers. Updates over the limit are passed to exceeded, if not nil, or
+// dropped. Updates without a sender are not limited.
+func UserRateLimit(l Limit, exceeded Handler) Middleware {
+	var (
+		mu        sync.Mutex
+		users     = make(map[int64]*bucket)
+		lastSweep time.Time
+	)
+	allow := func(id int64, now time.Time) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if now.Sub(lastSweep) > time.Minute {
+			for id, b := range users {
+				if b.full(now) {
+					delete(users, id)
+				}
+			}
+			lastSweep = now
+		}
+		b, ok := users[id]
+		if !ok {
+			b = newBucket(l)
+			users[id] = b
+		}
+		if b.delay(now) > 0 {
+			return false
+		}
+		b.take(now)
+		return true
+	}
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, u *Update) {
+			s := u.Sender()
+			if s == nil || allow(s.Id, time.Now()) {
+				next.HandleUpdate(ctx, u)
+				return
+			}
+			if exceeded != nil {
+				exceeded.HandleUpdate(ctx, u)
+			}
+		})
+	}
+}
+
+// Metrics calls observe after each update is handled, with the time it took
+// to handle it. The observe function must be safe for concurrent use.
+func Metrics(observe func(u *Update, d time.Duration)) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, u *Update) {
+			start := time.Now()
+			defer func() {
+				observe(u, time.Since(start))
+			}()
+			next.HandleUpdate(ctx, u)
+		})
+	}
+}
+
+// Tracing calls start before each update is handled, allowing a tracing
+// span to be added to the context. The returned function is called to end
+// the span once the update is handled.
+func Tracing(start func(ctx context.Context, u *Update) (context.Context, func())) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, u *Update) {
+			ctx, end := start(ctx, u)
+			defer end()
+			next.HandleUpdate(ctx, u)
+		})
+	}
+}
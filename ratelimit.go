@@ -0,0 +1,350 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limiter schedules outgoing messages so that the bot stays within the Bot
+// API limits. The ApiClient calls Wait before every request that sends a
+// message (send*, forward* and copy* methods, except sendChatAction) to a
+// chat.
+type Limiter interface {
+	// Wait blocks until a message can be sent to chatId, or ctx is done.
+	Wait(ctx context.Context, chatId string) error
+}
+
+// SetLimiter configures the client to schedule messages using l.
+// A nil Limiter, the default, disables client side rate limiting.
+func (t *ApiClient) SetLimiter(l Limiter) {
+	t.limiter = l
+}
+
+// wait blocks on the client Limiter if apiMethod sends a message to chatId.
+func (t *ApiClient) wait(ctx context.Context, apiMethod, chatId string) error {
+	if t.limiter == nil || chatId == "" {
+		return nil
+	}
+	if !sendsMessage(apiMethod) {
+		return nil
+	}
+	return t.limiter.Wait(ctx, chatId)
+}
+
+// sendsMessage reports whether apiMethod sends messages to a chat, using the
+// chat message budget.
+func sendsMessage(apiMethod string) bool {
+	m := strings.ToLower(apiMethod)
+	if m == "sendchataction" {
+		return false
+	}
+	return strings.HasPrefix(m, "send") || strings.HasPrefix(m, "forward") || strings.HasPrefix(m, "copy")
+}
+
+// bodyChatId returns the chat_id of a JSON encoded request body.
+func bodyChatId(body []byte) string {
+	var params struct {
+		ChatId json.RawMessage `json:"chat_id"`
+	}
+	if err := json.Unmarshal(body, &params); err != nil || len(params.ChatId) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(params.ChatId, &s); err == nil {
+		return s
+	}
+	return string(params.ChatId)
+}
+
+// Limit allows up to Count events on each Per interval.
+type Limit struct {
+	Count int
+	Per   time.Duration
+}
+
+var (
+	// DefaultGlobalLimit is the overall message rate allowed for a bot.
+	DefaultGlobalLimit = Limit{Count: 30, Per: time.Second}
+	// DefaultPrivateChatLimit is the message rate allowed per private chat.
+	DefaultPrivateChatLimit = Limit{Count: 1, Per: time.Second}
+	// DefaultGroupChatLimit is the message rate allowed per group or channel.
+	DefaultGroupChatLimit = Limit{Count: 20, Per: time.Minute}
+)
+
+// ChatLimiter is a Limiter that enforces a global message rate and a per
+// chat message rate, depending on the chat kind.
+//
+// Messages that exceed the limits are queued, and queued chats are served in
+// round-robin order, so a single busy chat can't starve the others.
+type ChatLimiter struct {
+	global  Limit
+	private Limit
+	group   Limit
+
+	mu         sync.Mutex
+	bucket     *bucket
+	chats      map[string]*chatQueue
+	ring       []string
+	pending    int
+	running    bool
+	wake       chan struct{}
+	lastSweep  time.Time
+	sweepEvery time.Duration
+}
+
+// NewChatLimiter returns a ChatLimiter using the provided limits.
+// Chats with a positive numeric identifier are considered private chats;
+// every other chat, including @channelusername, uses the group limit.
+func NewChatLimiter(global, private, group Limit) *ChatLimiter {
+	return &ChatLimiter{
+		global:     global,
+		private:    private,
+		group:      group,
+		bucket:     newBucket(global),
+		chats:      make(map[string]*chatQueue),
+		wake:       make(chan struct{}, 1),
+		sweepEvery: time.Minute,
+	}
+}
+
+// NewDefaultChatLimiter returns a ChatLimiter that uses the limits documented
+// for the Bot API.
+func NewDefaultChatLimiter() *ChatLimiter {
+	return NewChatLimiter(DefaultGlobalLimit, DefaultPrivateChatLimit, DefaultGroupChatLimit)
+}
+
+// Wait implements the Limiter interface.
+func (l *ChatLimiter) Wait(ctx context.Context, chatId string) error {
+	w := make(chan struct{})
+
+	l.mu.Lock()
+	// Idle chats are also swept here, as the dispatcher only runs while
+	// messages are queued.
+	if now := time.Now(); now.Sub(l.lastSweep) > l.sweepEvery {
+		l.sweep(now)
+		l.lastSweep = now
+	}
+	q, ok := l.chats[chatId]
+	if !ok {
+		q = &chatQueue{bucket: newBucket(l.chatLimit(chatId))}
+		l.chats[chatId] = q
+	}
+	if len(q.waiters) == 0 {
+		l.ring = append(l.ring, chatId)
+	}
+	q.waiters = append(q.waiters, w)
+	l.pending++
+	if !l.running {
+		l.running = true
+		go l.dispatch()
+	} else {
+		l.notify()
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-w:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if !q.remove(w) {
+			// Already granted while we were cancelled.
+			return nil
+		}
+		l.pending--
+		if len(q.waiters) == 0 {
+			l.removeFromRing(chatId)
+		}
+		return ctx.Err()
+	}
+}
+
+// QueueDepth returns the number of messages waiting to be sent.
+func (l *ChatLimiter) QueueDepth() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.pending
+}
+
+// ChatQueueDepth returns the number of messages waiting to be sent to chatId.
+func (l *ChatLimiter) ChatQueueDepth(chatId string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if q, ok := l.chats[chatId]; ok {
+		return len(q.waiters)
+	}
+	return 0
+}
+
+// QueuedChats returns the number of chats with messages waiting to be sent.
+func (l *ChatLimiter) QueuedChats() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.ring)
+}
+
+func (l *ChatLimiter) chatLimit(chatId string) Limit {
+	if id, err := strconv.ParseInt(chatId, 10, 64); err == nil && id > 0 {
+		return l.private
+	}
+	return l.group
+}
+
+// notify wakes up the dispatcher. Must be called with l.mu held.
+func (l *ChatLimiter) notify() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch releases queued waiters as the limits allow, until the queue is
+// empty.
+func (l *ChatLimiter) dispatch() {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		delay := l.release(now)
+		if now.Sub(l.lastSweep) > l.sweepEvery {
+			l.sweep(now)
+			l.lastSweep = now
+		}
+		if len(l.ring) == 0 {
+			l.running = false
+			l.mu.Unlock()
+			return
+		}
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-l.wake:
+			timer.Stop()
+		}
+	}
+}
+
+// release grants as many waiters as possible in round-robin order, and
+// returns how long to wait before trying again.
+func (l *ChatLimiter) release(now time.Time) time.Duration {
+	var next time.Duration = -1
+	for i := 0; i < len(l.ring); {
+		if d := l.bucket.delay(now); d > 0 {
+			return d
+		}
+		id := l.ring[i]
+		q := l.chats[id]
+		if d := q.bucket.delay(now); d > 0 {
+			if next < 0 || d < next {
+				next = d
+			}
+			i++
+			continue
+		}
+		l.bucket.take(now)
+		q.bucket.take(now)
+		close(q.waiters[0])
+		q.waiters = q.waiters[1:]
+		l.pending--
+
+		// Move the chat to the end of the ring, or drop it if drained.
+		l.ring = append(l.ring[:i], l.ring[i+1:]...)
+		if len(q.waiters) > 0 {
+			l.ring = append(l.ring, id)
+		}
+	}
+	if next < 0 {
+		next = l.sweepEvery
+	}
+	return next
+}
+
+// sweep removes idle chats whose limits are fully replenished.
+func (l *ChatLimiter) sweep(now time.Time) {
+	for id, q := range l.chats {
+		if len(q.waiters) == 0 && q.bucket.full(now) {
+			delete(l.chats, id)
+		}
+	}
+}
+
+func (l *ChatLimiter) removeFromRing(chatId string) {
+	for i, id := range l.ring {
+		if id == chatId {
+			l.ring = append(l.ring[:i], l.ring[i+1:]...)
+			return
+		}
+	}
+}
+
+type chatQueue struct {
+	bucket  *bucket
+	waiters []chan struct{}
+}
+
+func (q *chatQueue) remove(w chan struct{}) bool {
+	for i, c := range q.waiters {
+		if c == w {
+			q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// bucket is a token bucket that holds up to Limit.Count tokens, refilled
+// at a rate of Limit.Count per Limit.Per.
+type bucket struct {
+	capacity float64
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+func newBucket(l Limit) *bucket {
+	if l.Count <= 0 {
+		l.Count = 1
+	}
+	return &bucket{
+		capacity: float64(l.Count),
+		interval: l.Per / time.Duration(l.Count),
+		tokens:   float64(l.Count),
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if !b.last.IsZero() && b.interval > 0 {
+		b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	} else if b.interval <= 0 {
+		b.tokens = b.capacity
+	}
+	b.last = now
+}
+
+// delay returns how long until a token is available.
+func (b *bucket) delay(now time.Time) time.Duration {
+	b.refill(now)
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) * float64(b.interval))
+}
+
+func (b *bucket) take(now time.Time) {
+	b.refill(now)
+	b.tokens--
+}
+
+func (b *bucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.capacity
+}
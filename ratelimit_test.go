@@ -0,0 +1,156 @@
+package telegram
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitQueued waits until l has n queued messages.
+func waitQueued(t *testing.T, l *ChatLimiter, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for l.QueueDepth() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d queued messages, want %d", l.QueueDepth(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestChatLimiterRoundRobin(t *testing.T) {
+	fast := Limit{Count: 100, Per: time.Millisecond}
+	l := NewChatLimiter(Limit{Count: 1, Per: 20 * time.Millisecond}, fast, fast)
+	ctx := context.Background()
+
+	// Use the only global token, so the next messages are queued.
+	if err := l.Wait(ctx, "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var order []string
+	var wg sync.WaitGroup
+	send := func(chatId, name string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(ctx, chatId); err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+		}()
+	}
+	send("1", "a2")
+	waitQueued(t, l, 1)
+	send("1", "a3")
+	waitQueued(t, l, 2)
+	send("2", "b1")
+	waitQueued(t, l, 3)
+	wg.Wait()
+
+	want := []string{"a2", "b1", "a3"}
+	if len(order) != len(want) {
+		t.Fatalf("got %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("got %v, want %v", order, want)
+		}
+	}
+}
+
+func TestChatLimiterQueueDepth(t *testing.T) {
+	fast := Limit{Count: 100, Per: time.Millisecond}
+	l := NewChatLimiter(Limit{Count: 1, Per: time.Hour}, fast, fast)
+	if err := l.Wait(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i, chatId := range []string{"1", "1", "2"} {
+		wg.Add(1)
+		go func(chatId string) {
+			defer wg.Done()
+			if err := l.Wait(ctx, chatId); err != context.Canceled {
+				t.Errorf("got %v, want context.Canceled", err)
+			}
+		}(chatId)
+		waitQueued(t, l, i+1)
+	}
+
+	if got := l.ChatQueueDepth("1"); got != 2 {
+		t.Errorf("got ChatQueueDepth(1) = %d, want 2", got)
+	}
+	if got := l.ChatQueueDepth("2"); got != 1 {
+		t.Errorf("got ChatQueueDepth(2) = %d, want 1", got)
+	}
+	if got := l.QueuedChats(); got != 2 {
+		t.Errorf("got QueuedChats() = %d, want 2", got)
+	}
+
+	cancel()
+	wg.Wait()
+	if got := l.QueueDepth(); got != 0 {
+		t.Errorf("got QueueDepth() = %d after cancel, want 0", got)
+	}
+	if got := l.QueuedChats(); got != 0 {
+		t.Errorf("got QueuedChats() = %d after cancel, want 0", got)
+	}
+}
+
+func TestChatLimiterCancel(t *testing.T) {
+	fast := Limit{Count: 100, Per: time.Millisecond}
+	l := NewChatLimiter(Limit{Count: 1, Per: time.Hour}, fast, fast)
+	if err := l.Wait(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, "1"); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if got := l.ChatQueueDepth("1"); got != 0 {
+		t.Errorf("got ChatQueueDepth(1) = %d, want 0", got)
+	}
+}
+
+func TestChatLimiterSweep(t *testing.T) {
+	l := NewChatLimiter(Limit{Count: 100, Per: time.Millisecond}, Limit{Count: 1, Per: time.Millisecond}, Limit{Count: 1, Per: time.Millisecond})
+	l.sweepEvery = time.Millisecond
+	ctx := context.Background()
+	if err := l.Wait(ctx, "1"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := l.Wait(ctx, "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	l.mu.Lock()
+	_, idle := l.chats["1"]
+	n := len(l.chats)
+	l.mu.Unlock()
+	if idle || n != 1 {
+		t.Errorf("got %d chats, want only the last one after the sweep", n)
+	}
+}
+
+func TestSendsMessage(t *testing.T) {
+	for method, want := range map[string]bool{
+		"sendMessage":    true,
+		"copyMessages":   true,
+		"forwardMessage": true,
+		"sendChatAction": false,
+		"getChat":        false,
+	} {
+		if got := sendsMessage(method); got != want {
+			t.Errorf("sendsMessage(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package telegram
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the ApiClient retries requests rejected by the
+// Bot API due to flood control or chat migration.
+//
+// Only these failures are retried, because Telegram rejects the request
+// before processing it, so it is safe to repeat any call. Network errors
+// and multipart uploads are never retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is repeated.
+	MaxRetries int
+	// MaxWait is the longest retry_after the client is willing to sleep
+	// for. Requests asked to wait longer fail immediately. Zero means no
+	// limit.
+	MaxWait time.Duration
+	// FollowMigration repeats calls made to a group that was upgraded to a
+	// supergroup, replacing the chat_id with migrate_to_chat_id.
+	FollowMigration bool
+}
+
+// DefaultRetryPolicy retries flood controlled requests up to three times,
+// waiting at most one minute, and follows chat migrations.
+var DefaultRetryPolicy = &RetryPolicy{
+	MaxRetries:      3,
+	MaxWait:         1 * time.Minute,
+	FollowMigration: true,
+}
+
+// SetRetryPolicy enables automatic retries using the provided policy.
+// A nil policy, the default, disables retries.
+func (t *ApiClient) SetRetryPolicy(p *RetryPolicy) {
+	t.retry = p
+}
+
+// retryBody checks if a request that failed with err should be repeated
+// according to the client RetryPolicy, returning the wait time and the body
+// for the next attempt.
+func (t *ApiClient) retryBody(apiMethod string, body []byte, attempt int, err error) (time.Duration, []byte, bool) {
+	p := t.retry
+	if p == nil || attempt >= p.MaxRetries {
+		return 0, nil, false
+	}
+	e, ok := asAPIError(err)
+	if !ok {
+		return 0, nil, false
+	}
+	switch {
+	case e.RetryAfter > 0:
+		if p.MaxWait > 0 && e.RetryAfter > p.MaxWait {
+			return 0, nil, false
+		}
+		t.Debugf("telegram: %s: flood control exceeded, retrying in %v (retry %d of %d)",
+			apiMethod, e.RetryAfter, attempt+1, p.MaxRetries)
+		return e.RetryAfter, body, true
+	case e.MigrateToChatId != 0 && p.FollowMigration:
+		b, ok := withChatId(body, e.MigrateToChatId)
+		if !ok {
+			return 0, nil, false
+		}
+		t.Debugf("telegram: %s: chat migrated to %d, retrying (retry %d of %d)",
+			apiMethod, e.MigrateToChatId, attempt+1, p.MaxRetries)
+		return 0, b, true
+	}
+	return 0, nil, false
+}
+
+// withChatId replaces the chat_id in a JSON encoded request body.
+func withChatId(body []byte, chatId int64) ([]byte, bool) {
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(body, &params); err != nil {
+		return nil, false
+	}
+	if _, ok := params["chat_id"]; !ok {
+		return nil, false
+	}
+	params["chat_id"] = json.RawMessage(strconv.FormatInt(chatId, 10))
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
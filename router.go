@@ -0,0 +1,187 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// Command is a bot command, such as /help or /start@MyBot payload, parsed
+// from the beginning of a message.
+type Command struct {
+	// Name is the command name, in lower case, without the leading slash
+	// and the bot username.
+	Name string
+	// Bot is the username the command was addressed to, if any.
+	Bot string
+	// RawArgs is the text following the command, with surrounding spaces
+	// removed.
+	RawArgs string
+	// Args are the RawArgs split by spaces. Quoted strings are kept
+	// together as a single argument.
+	Args []string
+	// Message is the message containing the command.
+	Message *Message
+}
+
+// Payload returns the deep-link parameter of a /start command, sent when a
+// user opens the bot through a https://t.me/<bot>?start=<payload> link.
+func (c *Command) Payload() string {
+	if c.Name != "start" {
+		return ""
+	}
+	return c.RawArgs
+}
+
+// utf16Len returns the length of s in UTF-16 code units, the unit used by
+// Telegram for entity offsets and text limits.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n++
+		if r >= 0x10000 {
+			n++
+		}
+	}
+	return n
+}
+
+// ParseCommand returns the bot command at the beginning of m, or nil if the
+// message does not start with a bot_command entity.
+func ParseCommand(m *Message) *Command {
+	if m == nil || m.Text == "" {
+		return nil
+	}
+	for _, e := range m.Entities {
+		if e.Type != MessageEntityTypeBotCommand || e.Offset != 0 {
+			continue
+		}
+		text := utf16.Encode([]rune(m.Text))
+		if e.Length <= 1 || e.Length > int64(len(text)) {
+			return nil
+		}
+		name := string(utf16.Decode(text[1:e.Length]))
+		rest := strings.TrimSpace(string(utf16.Decode(text[e.Length:])))
+		cmd := &Command{
+			RawArgs: rest,
+			Args:    SplitArgs(rest),
+			Message: m,
+		}
+		if i := strings.Index(name, "@"); i >= 0 {
+			name, cmd.Bot = name[:i], name[i+1:]
+		}
+		cmd.Name = strings.ToLower(name)
+		return cmd
+	}
+	return nil
+}
+
+// SplitArgs splits s around spaces, keeping text enclosed in single or double
+// quotes together. A backslash escapes the next character.
+func SplitArgs(s string) []string {
+	var (
+		args    []string
+		buff    strings.Builder
+		quote   rune
+		escaped bool
+		inArg   bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			buff.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				buff.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, buff.String())
+				buff.Reset()
+				inArg = false
+			}
+		default:
+			buff.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, buff.String())
+	}
+	return args
+}
+
+// CommandFunc handles a bot command received in the message of u.
+type CommandFunc func(ctx context.Context, u *Update, cmd *Command)
+
+// Router is a Handler that dispatches updates with bot commands to the
+// function registered for each command.
+//
+// Commands addressed to other bots, such as /help@OtherBot in a group, are
+// ignored. Any other update, including unknown commands, is passed to the
+// default Handler, if any.
+type Router struct {
+	username   string
+	commands   map[string]CommandFunc
+	fallback   Handler
+	middleware []Middleware
+	chain      Handler
+}
+
+// NewRouter returns a Router for the bot with the given username.
+func NewRouter(botUsername string) *Router {
+	return &Router{
+		username: strings.TrimPrefix(botUsername, "@"),
+		commands: make(map[string]CommandFunc),
+	}
+}
+
+// Handle registers f to handle the command, with or without the leading
+// slash. Command names are case insensitive.
+func (r *Router) Handle(command string, f CommandFunc) {
+	r.commands[strings.ToLower(strings.TrimPrefix(command, "/"))] = f
+}
+
+// Default registers the Handler for updates without a registered command.
+func (r *Router) Default(h Handler) {
+	r.fallback = h
+}
+
+// Use adds middleware around every update handled by the Router, including
+// the ones passed to the default Handler.
+func (r *Router) Use(mw ...Middleware) {
+	r.middleware = append(r.middleware, mw...)
+	r.chain = Chain(HandlerFunc(r.dispatch), r.middleware...)
+}
+
+// HandleUpdate implements the Handler interface.
+func (r *Router) HandleUpdate(ctx context.Context, u *Update) {
+	if r.chain != nil {
+		r.chain.HandleUpdate(ctx, u)
+		return
+	}
+	r.dispatch(ctx, u)
+}
+
+func (r *Router) dispatch(ctx context.Context, u *Update) {
+	if cmd := ParseCommand(u.Message); cmd != nil {
+		if cmd.Bot != "" && !strings.EqualFold(cmd.Bot, r.username) {
+			return
+		}
+		if f, ok := r.commands[cmd.Name]; ok {
+			f(ctx, u, cmd)
+			return
+		}
+	}
+	if r.fallback != nil {
+		r.fallback.HandleUpdate(ctx, u)
+	}
+}
@@ -0,0 +1,182 @@
+package telegram
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DefaultPollTimeout is the long polling timeout used by a Poller.
+	DefaultPollTimeout = 30 * time.Second
+	// DefaultPollMinBackoff is the initial wait time after a failed poll.
+	DefaultPollMinBackoff = 1 * time.Second
+	// DefaultPollMaxBackoff is the maximum wait time between failed polls.
+	DefaultPollMaxBackoff = 1 * time.Minute
+)
+
+// GetUpdates receives incoming updates using long polling.
+//
+// Only updates with an identifier greater or equal to offset are returned;
+// limit caps the number of updates (1-100, 0 for the server default), and
+// timeout is the long polling timeout in seconds. The allowedUpdates list
+// filters the update types to receive; nil keeps the previous setting.
+//
+// The http.Client used by the ApiClient must have a Timeout larger than the
+// long polling timeout, or requests will be aborted before Telegram replies.
+func (t *ApiClient) GetUpdates(offset int64, limit, timeout int, allowedUpdates []string) ([]*Update, error) {
+	return t.GetUpdatesContext(context.Background(), offset, limit, timeout, allowedUpdates)
+}
+
+// GetUpdatesContext is like GetUpdates, but the request is bound to ctx.
+func (t *ApiClient) GetUpdatesContext(ctx context.Context, offset int64, limit, timeout int, allowedUpdates []string) ([]*Update, error) {
+	params := map[string]interface{}{}
+	if offset != 0 {
+		params["offset"] = offset
+	}
+	if limit > 0 {
+		params["limit"] = limit
+	}
+	if timeout > 0 {
+		params["timeout"] = timeout
+	}
+	if allowedUpdates != nil {
+		params["allowed_updates"] = allowedUpdates
+	}
+	var updates []*Update
+	if err := t.CallContext(ctx, "POST", "getUpdates", params, &updates); err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// Poller receives updates from Telegram using long polling, keeping track of
+// the offset so that each update is delivered only once.
+//
+// Errors are reported to the client DebugFunc and retried with an exponential
+// backoff, until the context is cancelled.
+type Poller struct {
+	// Timeout is the long polling timeout. Defaults to DefaultPollTimeout.
+	Timeout time.Duration
+	// Limit is the maximum number of updates fetched on each poll.
+	Limit int
+	// AllowedUpdates filters the update types to receive.
+	AllowedUpdates []string
+	// MinBackoff is the wait time after the first failed poll.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum wait time between failed polls.
+	MaxBackoff time.Duration
+
+	client *ApiClient
+	offset int64
+}
+
+// NewPoller returns a Poller that fetches updates using the provided client.
+func NewPoller(c *ApiClient) *Poller {
+	return &Poller{
+		Timeout:    DefaultPollTimeout,
+		MinBackoff: DefaultPollMinBackoff,
+		MaxBackoff: DefaultPollMaxBackoff,
+		client:     c,
+	}
+}
+
+// Offset returns the identifier of the next update to be fetched.
+func (p *Poller) Offset() int64 {
+	return p.offset
+}
+
+// SetOffset changes the identifier of the next update to be fetched.
+// Use it to resume polling from a previously saved Offset.
+func (p *Poller) SetOffset(offset int64) {
+	p.offset = offset
+}
+
+// Run fetches updates and calls h for each one of them, in order, until ctx
+// is cancelled. It always returns a non-nil error, ctx.Err().
+func (p *Poller) Run(ctx context.Context, h Handler) error {
+	return p.poll(ctx, func(u *Update) bool {
+		h.HandleUpdate(ctx, u)
+		return true
+	})
+}
+
+// Updates starts polling in background, delivering updates on the returned
+// channel. The channel is closed after ctx is cancelled.
+func (p *Poller) Updates(ctx context.Context) <-chan *Update {
+	ch := make(chan *Update)
+	go func() {
+		defer close(ch)
+		p.poll(ctx, func(u *Update) bool {
+			select {
+			case ch <- u:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return ch
+}
+
+// poll fetches updates until ctx is done, passing each one to deliver.
+// If deliver returns false, the update is not acknowledged and polling stops.
+func (p *Poller) poll(ctx context.Context, deliver func(u *Update) bool) error {
+	backoff := time.Duration(0)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		updates, err := p.client.GetUpdatesContext(ctx, p.offset, p.Limit, int(p.Timeout/time.Second), p.AllowedUpdates)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			backoff = p.nextBackoff(backoff)
+			p.client.Debugf("telegram: getUpdates failed, retrying in %v: %v", backoff, err)
+			if err := sleepContext(ctx, backoff); err != nil {
+				return err
+			}
+			continue
+		}
+		backoff = 0
+		for _, u := range updates {
+			if u.UpdateId < p.offset {
+				continue
+			}
+			if !deliver(u) {
+				return ctx.Err()
+			}
+			p.offset = u.UpdateId + 1
+		}
+	}
+}
+
+func (p *Poller) nextBackoff(prev time.Duration) time.Duration {
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = DefaultPollMinBackoff
+	}
+	if max < min {
+		max = min
+	}
+	next := prev * 2
+	if next < min {
+		next = min
+	}
+	if next > max {
+		next = max
+	}
+	return next
+}
+
+// sleepContext waits for d to elapse, returning earlier if ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,346 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// InputFile is a file to be sent to Telegram. It can be the identifier of a
+// file already stored on the Telegram servers, an HTTP URL for Telegram to
+// download the file from, or the contents of a new file to upload.
+type InputFile struct {
+	// FileId of a file that exists on the Telegram servers.
+	FileId string
+	// Url of the file, to be downloaded by Telegram.
+	Url string
+	// Name is the file name of an upload.
+	Name string
+	// MimeType is the content type of an upload.
+	// Defaults to application/octet-stream.
+	MimeType string
+	// Reader has the contents of the file to upload.
+	Reader io.Reader
+}
+
+// FileID returns an InputFile that sends an existing file by its identifier.
+func FileID(fileId string) *InputFile {
+	return &InputFile{FileId: fileId}
+}
+
+// FileURL returns an InputFile that Telegram downloads from url.
+func FileURL(url string) *InputFile {
+	return &InputFile{Url: url}
+}
+
+// FileReader returns an InputFile that uploads the contents of r with the
+// provided file name.
+func FileReader(name string, r io.Reader) *InputFile {
+	return &InputFile{Name: name, Reader: r}
+}
+
+var errUploadNotMultipart = errors.New("telegram: InputFile uploads must be sent as multipart/form-data")
+
+// MarshalJSON encodes the file identifier or URL as a JSON string.
+// Uploads can't be JSON encoded.
+func (f *InputFile) MarshalJSON() ([]byte, error) {
+	switch {
+	case f.Reader != nil:
+		return nil, errUploadNotMultipart
+	case f.FileId != "":
+		return json.Marshal(f.FileId)
+	default:
+		return json.Marshal(f.Url)
+	}
+}
+
+// UnmarshalJSON decodes a file identifier or an HTTP URL.
+func (f *InputFile) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "attach://") {
+		*f = InputFile{Url: s}
+	} else {
+		*f = InputFile{FileId: s}
+	}
+	return nil
+}
+
+func (f *InputFile) isUpload() bool {
+	return f != nil && f.Reader != nil
+}
+
+var inputFileType = reflect.TypeOf((*InputFile)(nil))
+
+// attachFields can't receive the upload directly, and must reference a
+// separate attach://<name> form part instead.
+var attachFields = map[string]bool{
+	"thumbnail": true,
+}
+
+// callUpload invokes apiMethod with the params struct in. If any *InputFile
+// field of in is an upload, the request is sent as a streaming multipart
+// form; otherwise it is sent as JSON.
+func (t *ApiClient) callUpload(ctx context.Context, apiMethod string, in, out interface{}) error {
+	params, files, err := splitUploads(in)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return t.CallContext(ctx, "POST", apiMethod, in, out)
+	}
+	if err := t.validateParams(in); err != nil {
+		return err
+	}
+	values := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		values[k] = v
+	}
+	return t.callMultipart(ctx, apiMethod, values, files, out)
+}
+
+// splitUploads JSON encodes the params struct in, except for *InputFile
+// fields that are uploads, which are returned as multipart files.
+func splitUploads(in interface{}) (map[string]json.RawMessage, []multipartFile, error) {
+	v := reflect.ValueOf(in)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("telegram: params must be a struct, got %T", in)
+	}
+
+	// Work on a copy, so that uploads can be removed before encoding.
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	var files []multipartFile
+	var uploads []string
+	attach := make(map[string]string)
+	for i := 0; i < c.NumField(); i++ {
+		f := c.Field(i)
+		if nestsUploads(f) {
+			if attached, ok := attachUploads(f, &files); ok {
+				f.Set(attached)
+			}
+			continue
+		}
+		if f.Type() != inputFileType || f.IsNil() {
+			continue
+		}
+		file := f.Interface().(*InputFile)
+		if !file.isUpload() {
+			continue
+		}
+		name := strings.Split(c.Type().Field(i).Tag.Get("json"), ",")[0]
+		uploads = append(uploads, name)
+		field := name
+		if attachFields[name] {
+			field = "attach_" + name
+			attach[name] = "attach://" + field
+		}
+		files = append(files, multipartFile{field: field, name: file.Name, mimeType: file.MimeType, r: file.Reader})
+		f.Set(reflect.Zero(inputFileType))
+	}
+
+	b, err := json.Marshal(c.Addr().Interface())
+	if err != nil {
+		return nil, nil, err
+	}
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(b, &params); err != nil {
+		return nil, nil, err
+	}
+	for _, k := range uploads {
+		delete(params, k)
+	}
+	for k, v := range attach {
+		params[k], _ = json.Marshal(v)
+	}
+	return params, files, nil
+}
+
+// nestsUploads reports whether v, a field of a params struct, can hold
+// uploads nested in other values, such as the media of an InputMedia or the
+// media of a group.
+func nestsUploads(v reflect.Value) bool {
+	if v.Type() == inputFileType || !v.CanSet() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr, reflect.Slice:
+		return !v.IsNil()
+	case reflect.Array:
+		return true
+	}
+	return false
+}
+
+// attachUploads handles uploads nested in a param value, such as the media
+// of an InputMedia, or of each InputMedia in a slice. If v holds structs with
+// *InputFile uploads, it returns a copy where they are replaced by
+// attach://<name> references to new multipart files.
+func attachUploads(v reflect.Value, files *[]multipartFile) (reflect.Value, bool) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	switch {
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		if v.Kind() == reflect.Slice {
+			c = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		}
+		reflect.Copy(c, v)
+		changed := false
+		for i := 0; i < c.Len(); i++ {
+			e := c.Index(i)
+			if !nestsUploads(e) {
+				continue
+			}
+			if attached, ok := attachUploads(e, files); ok {
+				e.Set(attached)
+				changed = true
+			}
+		}
+		return c, changed
+	case v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct:
+		return v, false
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	changed := false
+	for i := 0; i < c.Elem().NumField(); i++ {
+		f := c.Elem().Field(i)
+		if nestsUploads(f) {
+			if attached, ok := attachUploads(f, files); ok {
+				f.Set(attached)
+				changed = true
+			}
+			continue
+		}
+		if f.Type() != inputFileType || f.IsNil() || !f.CanSet() {
+			continue
+		}
+		file := f.Interface().(*InputFile)
+		if !file.isUpload() {
+			continue
+		}
+		name := fmt.Sprintf("attach_%d", len(*files))
+		*files = append(*files, multipartFile{field: name, name: file.Name, mimeType: file.MimeType, r: file.Reader})
+		f.Set(reflect.ValueOf(&InputFile{Url: "attach://" + name}))
+		changed = true
+	}
+	return c, changed
+}
+
+// multipartFile is a file to be uploaded with callMultipart.
+type multipartFile struct {
+	field    string
+	name     string
+	mimeType string
+	r        io.Reader
+}
+
+// callMultipart sends the params as a multipart/form-data request, attaching
+// files to the respective form fields. Values that are not strings are sent
+// JSON encoded, as expected by the Bot API.
+//
+// The request body is streamed, so files are never fully kept in memory.
+func (t *ApiClient) callMultipart(ctx context.Context, apiMethod string, params map[string]interface{}, files []multipartFile, out interface{}) error {
+	if chatId, ok := params["chat_id"]; ok {
+		id, err := formValue(chatId)
+		if err != nil {
+			return err
+		}
+		if err := t.wait(ctx, apiMethod, id); err != nil {
+			return err
+		}
+	}
+
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeMultipart(w, params, files))
+	}()
+	defer pr.Close()
+
+	req, err := http.NewRequest("POST", t.endpoint(apiMethod), pr)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return t.makeRequest(req, out)
+}
+
+func writeMultipart(w *multipart.Writer, params map[string]interface{}, files []multipartFile) error {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		value, err := formValue(params[k])
+		if err != nil {
+			return err
+		}
+		if err := w.WriteField(k, value); err != nil {
+			return err
+		}
+	}
+	for _, f := range files {
+		name := f.name
+		if name == "" {
+			name = f.field
+		}
+		mimeType := f.mimeType
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			escapeQuotes(f.field), escapeQuotes(name)))
+		h.Set("Content-Type", mimeType)
+		fw, err := w.CreatePart(h)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(fw, f.r); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+func formValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.RawMessage:
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			return s, nil
+		}
+		return string(v), nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,48 @@
+package telegram
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSplitUploadsMediaGroup(t *testing.T) {
+	type sendMediaGroupParams struct {
+		ChatId string       `json:"chat_id"`
+		Media  []InputMedia `json:"media"`
+	}
+	first := FileReader("a.jpg", strings.NewReader("a"))
+	p := &sendMediaGroupParams{
+		ChatId: "1",
+		Media: []InputMedia{
+			&InputMediaPhoto{Media: first},
+			&InputMediaPhoto{Media: FileReader("b.jpg", strings.NewReader("b"))},
+		},
+	}
+
+	params, files, err := splitUploads(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got %d files, want 2", len(files))
+	}
+	for i, want := range []struct{ field, name string }{{"attach_0", "a.jpg"}, {"attach_1", "b.jpg"}} {
+		if files[i].field != want.field || files[i].name != want.name {
+			t.Errorf("file %d: got %s (%s), want %s (%s)", i, files[i].field, files[i].name, want.field, want.name)
+		}
+	}
+
+	var media []struct {
+		Media string `json:"media"`
+	}
+	if err := json.Unmarshal(params["media"], &media); err != nil {
+		t.Fatal(err)
+	}
+	if len(media) != 2 || media[0].Media != "attach://attach_0" || media[1].Media != "attach://attach_1" {
+		t.Errorf("got media %s, want attach:// references", params["media"])
+	}
+	if p.Media[0].(*InputMediaPhoto).Media != first {
+		t.Error("the params were changed")
+	}
+}
@@ -0,0 +1,132 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validator is implemented by the params structs that can check their
+// values against the limits documented by the Bot API.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is returned by Validate when some parameters are out of
+// the limits documented by the Bot API. It lists every violation.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+// FieldError is a violation of the limits of a single parameter.
+type FieldError struct {
+	// Field is the parameter name, as in the Bot API, such as "text".
+	Field string
+	// Reason describes the violation, such as "must be at most 4096
+	// characters".
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Reason
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return "telegram: invalid parameters: " + strings.Join(msgs, "; ")
+}
+
+// SetValidation enables checking the params structs implementing Validator
+// before sending them, so that requests out of the API limits fail with a
+// *ValidationError instead of a Bad Request from Telegram. It is disabled by
+// default.
+func (t *ApiClient) SetValidation(enabled bool) {
+	t.validate = enabled
+}
+
+// validateParams validates in, if validation is enabled and it implements
+// Validator.
+func (t *ApiClient) validateParams(in interface{}) error {
+	if !t.validate {
+		return nil
+	}
+	if v, ok := in.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validator collects the violations found by the generated Validate methods.
+type validator struct {
+	fields []*FieldError
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, &FieldError{Field: field, Reason: fmt.Sprintf(format, args...)})
+}
+
+// chars checks that s has min to max characters, counted in UTF-16 code
+// units as Telegram does.
+func (v *validator) chars(field, s string, min, max int) {
+	v.length(field, utf16Len(s), min, max, "characters")
+}
+
+// formatted checks that the text s has min to max characters after entities
+// parsing. With a parse mode, the markup is removed by Telegram, so the parsed
+// length is unknown but never larger than s, and only min is checked. Texts
+// with entities are sent as parsed, and are fully checked.
+func (v *validator) formatted(field, s string, min, max int, markup bool) {
+	if markup {
+		max = 0
+	}
+	v.chars(field, s, min, max)
+}
+
+// bytes checks that s has min to max bytes.
+func (v *validator) bytes(field, s string, min, max int) {
+	v.length(field, len(s), min, max, "bytes")
+}
+
+// items checks that a list has at most max items.
+func (v *validator) items(field string, n, max int) {
+	if n > max {
+		v.add(field, "must have at most %d items, got %d", max, n)
+	}
+}
+
+// length checks that n is between min and max. A max of 0 means there is no
+// upper limit.
+func (v *validator) length(field string, n, min, max int, unit string) {
+	switch {
+	case n < min && min == 1:
+		v.add(field, "must not be empty")
+	case n < min:
+		v.add(field, "must be at least %d %s, got %d", min, unit, n)
+	case max > 0 && n > max:
+		v.add(field, "must be at most %d %s, got %d", max, unit, n)
+	}
+}
+
+// intRange checks that n is between min and max.
+func (v *validator) intRange(field string, n, min, max int64) {
+	if n < min || n > max {
+		v.add(field, "must be between %d and %d, got %d", min, max, n)
+	}
+}
+
+// floatRange checks that n is between min and max.
+func (v *validator) floatRange(field string, n, min, max float64) {
+	if n < min || n > max {
+		v.add(field, "must be between %v and %v, got %v", min, max, n)
+	}
+}
+
+// err returns the *ValidationError with the violations found, or nil.
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
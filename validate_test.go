@@ -0,0 +1,76 @@
+package telegram
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateTextLength(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		p     Validator
+		field string
+	}{
+		{"empty text", &SendMessageParams{ChatId: "1"}, "text"},
+		{"long text", &SendMessageParams{ChatId: "1", Text: strings.Repeat("a", 4097)}, "text"},
+		{"long emoji text", &SendMessageParams{ChatId: "1", Text: strings.Repeat("😀", 2049)}, "text"},
+		{"long caption", &SendPhotoParams{ChatId: "1", Caption: strings.Repeat("a", 1025)}, "caption"},
+		{"long edited caption", &EditMessageCaptionParams{ChatId: "1", Caption: strings.Repeat("a", 1025)}, "caption"},
+		{"long text with entities", &SendMessageParams{ChatId: "1", Text: strings.Repeat("a", 5000), Entities: []*MessageEntity{{Type: "bold", Offset: 0, Length: 4}}}, "text"},
+		{"long chat title", &setChatTitleParams{ChatId: "1", Title: strings.Repeat("a", 129)}, "title"},
+		{"long chat description", &setChatDescriptionParams{ChatId: "1", Description: strings.Repeat("a", 256)}, "description"},
+		{"many webhook connections", &SetWebhookParams{URL: "https://example.com", MaxConnections: 101}, "max_connections"},
+		{"long secret token", &SetWebhookParams{URL: "https://example.com", SecretToken: strings.Repeat("a", 257)}, "secret_token"},
+		{"invalid secret token", &SetWebhookParams{URL: "https://example.com", SecretToken: "a b"}, "secret_token"},
+		{"long next offset", &AnswerInlineQueryParams{InlineQueryId: "q", NextOffset: strings.Repeat("a", 65)}, "next_offset"},
+	} {
+		err := tc.p.Validate()
+		var ve *ValidationError
+		if !errors.As(err, &ve) {
+			t.Errorf("%s: got %v, want a *ValidationError", tc.name, err)
+			continue
+		}
+		if len(ve.Fields) != 1 || ve.Fields[0].Field != tc.field {
+			t.Errorf("%s: got %v, want an error for %s", tc.name, err, tc.field)
+		}
+	}
+}
+
+func TestValidateFormattedText(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		p    Validator
+	}{
+		{"max text", &SendMessageParams{ChatId: "1", Text: strings.Repeat("😀", 2048)}},
+		{"html text", &SendMessageParams{ChatId: "1", Text: "<b>" + strings.Repeat("a", 4096) + "</b>", ParseMode: ParseModeHTML}},
+		{"html caption", &SendPhotoParams{ChatId: "1", Caption: "<b>" + strings.Repeat("a", 1024) + "</b>", ParseMode: ParseModeHTML}},
+	} {
+		if err := tc.p.Validate(); err != nil {
+			t.Errorf("%s: got %v, want no error", tc.name, err)
+		}
+	}
+}
+
+func TestValidateInlineQueryResults(t *testing.T) {
+	p := &AnswerInlineQueryParams{
+		Results: []InlineQueryResult{
+			&InlineQueryResultArticle{Id: "1"},
+			&InlineQueryResultArticle{Id: "1"},
+			&InlineQueryResultArticle{Id: strings.Repeat("a", 65)},
+			nil,
+		},
+	}
+	var ve *ValidationError
+	if err := p.Validate(); !errors.As(err, &ve) {
+		t.Fatalf("got %v, want a *ValidationError", err)
+	}
+	var fields []string
+	for _, f := range ve.Fields {
+		fields = append(fields, f.Field)
+	}
+	want := []string{"inline_query_id", "results[1].id", "results[2].id", "results[3]"}
+	if strings.Join(fields, " ") != strings.Join(want, " ") {
+		t.Errorf("got errors for %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,218 @@
+package telegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	// SecretTokenHeader is the header Telegram uses to send the secret token
+	// configured with SetWebhook.
+	SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+	// DefaultWebhookMaxBodySize is the maximum accepted update payload size.
+	DefaultWebhookMaxBodySize = 1 << 20
+)
+
+// WebhookHandler is an http.Handler that receives the updates Telegram posts
+// to the URL configured with SetWebhook, and dispatches them to a Handler.
+//
+// Updates are handled synchronously, and the request context is passed along
+// to the Handler, so the same Handler can be used with a Poller.
+type WebhookHandler struct {
+	// SecretToken, if not empty, must match the SecretTokenHeader sent by
+	// Telegram, or the request is rejected.
+	SecretToken string
+	// MaxBodySize is the maximum payload size in bytes.
+	// Defaults to DefaultWebhookMaxBodySize.
+	MaxBodySize int64
+
+	handler Handler
+}
+
+// NewWebhookHandler returns a WebhookHandler that validates requests against
+// secretToken and dispatches the decoded updates to h.
+func NewWebhookHandler(secretToken string, h Handler) *WebhookHandler {
+	return &WebhookHandler{
+		SecretToken: secretToken,
+		MaxBodySize: DefaultWebhookMaxBodySize,
+		handler:     h,
+	}
+}
+
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if wh.SecretToken != "" {
+		token := r.Header.Get(SecretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(wh.SecretToken)) != 1 {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
+	max := wh.MaxBodySize
+	if max <= 0 {
+		max = DefaultWebhookMaxBodySize
+	}
+	if r.ContentLength > max {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, max+1))
+	if err != nil {
+		http.Error(w, "unable to read request", http.StatusBadRequest)
+		return
+	}
+	if int64(len(b)) > max {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	u := new(Update)
+	if err := json.Unmarshal(b, u); err != nil || u.UpdateId == 0 {
+		http.Error(w, "invalid update", http.StatusBadRequest)
+		return
+	}
+	wh.handler.HandleUpdate(r.Context(), u)
+	w.WriteHeader(http.StatusOK)
+}
+
+// SetWebhookParams are the parameters of the setWebhook method.
+type SetWebhookParams struct {
+	// URL is the HTTPS URL to send updates to. Use an empty string to remove
+	// the webhook integration.
+	URL string
+	// Certificate is the public key certificate, in PEM format, for
+	// self-signed certificates. It is uploaded when not nil.
+	Certificate io.Reader
+	// IPAddress is the fixed IP address used to send webhook requests,
+	// instead of the address resolved through DNS.
+	IPAddress string
+	// MaxConnections is the maximum allowed number of simultaneous HTTPS
+	// connections to the webhook, 1-100. Defaults to 40.
+	MaxConnections int
+	// AllowedUpdates is the list of update types to receive. A nil value
+	// keeps the previous setting, and an empty list receives all of them.
+	AllowedUpdates []string
+	// DropPendingUpdates drops all pending updates.
+	DropPendingUpdates bool
+	// SecretToken is sent back by Telegram in the SecretTokenHeader of
+	// every webhook request, 1-256 characters.
+	SecretToken string
+}
+
+// Validate checks the maximum number of connections and the secret token.
+func (p *SetWebhookParams) Validate() error {
+	var v validator
+	if p.MaxConnections != 0 {
+		v.intRange("max_connections", int64(p.MaxConnections), 1, 100)
+	}
+	if p.SecretToken != "" {
+		v.chars("secret_token", p.SecretToken, 1, 256)
+		if !isSecretToken(p.SecretToken) {
+			v.add("secret_token", "must only have the characters A-Z, a-z, 0-9, _ and -")
+		}
+	}
+	return v.err()
+}
+
+// isSecretToken reports whether s only has the characters allowed in a
+// webhook secret token.
+func isSecretToken(s string) bool {
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *SetWebhookParams) values() map[string]interface{} {
+	params := map[string]interface{}{
+		"url": p.URL,
+	}
+	if p.IPAddress != "" {
+		params["ip_address"] = p.IPAddress
+	}
+	if p.MaxConnections > 0 {
+		params["max_connections"] = p.MaxConnections
+	}
+	if p.AllowedUpdates != nil {
+		params["allowed_updates"] = p.AllowedUpdates
+	}
+	if p.DropPendingUpdates {
+		params["drop_pending_updates"] = true
+	}
+	if p.SecretToken != "" {
+		params["secret_token"] = p.SecretToken
+	}
+	return params
+}
+
+// SetWebhook method configures the provided HTTPS endpoint as the bot callback.
+func (t *ApiClient) SetWebhook(httpsURL string) error {
+	return t.SetWebhookContext(context.Background(), httpsURL)
+}
+
+// SetWebhookContext is like SetWebhook, but the request is bound to ctx.
+func (t *ApiClient) SetWebhookContext(ctx context.Context, httpsURL string) error {
+	return t.SetWebhookWithParamsContext(ctx, &SetWebhookParams{URL: httpsURL})
+}
+
+// SetWebhookWithParams configures the bot webhook using all the options
+// supported by the setWebhook method.
+func (t *ApiClient) SetWebhookWithParams(p *SetWebhookParams) error {
+	return t.SetWebhookWithParamsContext(context.Background(), p)
+}
+
+// SetWebhookWithParamsContext is like SetWebhookWithParams, but the request
+// is bound to ctx.
+func (t *ApiClient) SetWebhookWithParamsContext(ctx context.Context, p *SetWebhookParams) error {
+	if err := t.validateParams(p); err != nil {
+		return err
+	}
+	var ok bool
+	if p.Certificate != nil {
+		files := []multipartFile{{field: "certificate", name: "certificate.pem", r: p.Certificate}}
+		return t.callMultipart(ctx, "setWebhook", p.values(), files, &ok)
+	}
+	return t.CallContext(ctx, "POST", "setWebhook", p.values(), &ok)
+}
+
+// DeleteWebhook removes the webhook integration, switching back to
+// GetUpdates. If dropPendingUpdates is true, all pending updates are dropped.
+func (t *ApiClient) DeleteWebhook(dropPendingUpdates bool) error {
+	return t.DeleteWebhookContext(context.Background(), dropPendingUpdates)
+}
+
+// DeleteWebhookContext is like DeleteWebhook, but the request is bound to ctx.
+func (t *ApiClient) DeleteWebhookContext(ctx context.Context, dropPendingUpdates bool) error {
+	params := map[string]interface{}{
+		"drop_pending_updates": dropPendingUpdates,
+	}
+	var ok bool
+	return t.CallContext(ctx, "POST", "deleteWebhook", params, &ok)
+}
+
+// GetWebhookInfo returns the current webhook status.
+func (t *ApiClient) GetWebhookInfo() (*WebhookInfo, error) {
+	return t.GetWebhookInfoContext(context.Background())
+}
+
+// GetWebhookInfoContext is like GetWebhookInfo, but the request is bound to ctx.
+func (t *ApiClient) GetWebhookInfoContext(ctx context.Context) (*WebhookInfo, error) {
+	info := new(WebhookInfo)
+	if err := t.CallContext(ctx, "GET", "getWebhookInfo", nil, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}